@@ -60,8 +60,7 @@ func (i *Implementation) handleTelegram(ctx context.Context, initData string) (*
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	role := "ADMIN" // TODO: брать из user
-	tp, err := i.issueTokens(user.ID, role)
+	tp, err := i.issueTokens(user.ID, user.Role)
 	if err != nil {
 		logger.Error("failed to issue tokens", "err", err.Error())
 		return nil, err
@@ -71,6 +70,7 @@ func (i *Implementation) handleTelegram(ctx context.Context, initData string) (*
 		UserId:       user.ID,
 		AccessToken:  tp.access,
 		RefreshToken: tp.refresh,
+		Role:         user.Role,
 	}, nil
 }
 
@@ -78,7 +78,7 @@ func (i *Implementation) handleJWT(_ context.Context, accessToken, refreshToken
 	if accessToken != "" {
 		claims, err := jwtUtils.VerifyToken(accessToken, i.jwtConfig.AccessSecret())
 		if err == nil {
-			return &desc.CheckResponse{UserId: claims.Id}, nil
+			return &desc.CheckResponse{UserId: claims.Id, Role: claims.Role}, nil
 		}
 
 		if !errors.Is(err, jwt.ErrTokenExpired) {
@@ -105,6 +105,7 @@ func (i *Implementation) handleJWT(_ context.Context, accessToken, refreshToken
 		UserId:       refreshClaims.Id,
 		AccessToken:  tp.access,
 		RefreshToken: tp.refresh,
+		Role:         refreshClaims.Role,
 	}, nil
 }
 
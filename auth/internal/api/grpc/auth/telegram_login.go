@@ -12,8 +12,6 @@ import (
 )
 
 func (i *Implementation) TelegramLogin(ctx context.Context, req *descAuth.TelegramLoginRequest) (*descAuth.TelegramLoginReponse, error) {
-	role := "ADMIN" // Get user role from db
-
 	user, err := i.service.GetByTelegramId(ctx, req.GetTelegramId())
 	if err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
@@ -24,7 +22,7 @@ func (i *Implementation) TelegramLogin(ctx context.Context, req *descAuth.Telegr
 
 	userInfo := authModel.UserInfo{
 		Id:   user.ID,
-		Role: role,
+		Role: user.Role,
 	}
 
 	refreshToken, err := jwtUtils.GenerateToken(userInfo, i.jwtConfig.RefreshSecret(), i.jwtConfig.RefreshExpiration())
@@ -9,6 +9,7 @@ import (
 	"github.com/M1steryO/RelocatorEvents/auth/internal/logger"
 	authModel "github.com/M1steryO/RelocatorEvents/auth/internal/service/user/model/auth"
 	jwtUtils "github.com/M1steryO/RelocatorEvents/auth/internal/utils/jwt"
+	"github.com/M1steryO/RelocatorEvents/auth/internal/utils/storage"
 	desc "github.com/M1steryO/RelocatorEvents/auth/pkg/user_v1"
 	"github.com/M1steryO/platform_common/pkg/sys"
 	"github.com/M1steryO/platform_common/pkg/sys/codes"
@@ -42,13 +43,13 @@ func (i *Implementation) Create(ctx context.Context, req *desc.CreateRequest) (*
 		return nil, err
 	}
 
-	accessToken, err := jwtUtils.GenerateToken(authModel.UserInfo{Id: id, Role: "user"}, []byte(accessTokenSecretKey), accessTokenExpiration)
+	accessToken, err := jwtUtils.GenerateToken(authModel.UserInfo{Id: id, Role: storage.GetRoleById(storage.RoleUser)}, []byte(accessTokenSecretKey), accessTokenExpiration)
 	if err != nil {
 		logger.Info("failed to generate access token:", slog.Any("error", err))
 		return nil, sys.NewCommonError("failed to generate token", codes.Internal)
 	}
 
-	refreshToken, err := jwtUtils.GenerateToken(authModel.UserInfo{Id: id, Role: "user"}, []byte(refreshTokenSecretKey), refreshTokenExpiration)
+	refreshToken, err := jwtUtils.GenerateToken(authModel.UserInfo{Id: id, Role: storage.GetRoleById(storage.RoleUser)}, []byte(refreshTokenSecretKey), refreshTokenExpiration)
 	if err != nil {
 		logger.Info("failed to generate refresh token:", slog.Any("error", err))
 		return nil, sys.NewCommonError("failed to refresh token", codes.Internal)
@@ -4,6 +4,7 @@ import "time"
 
 type User struct {
 	ID   int64
+	Role string
 	Info UserInfo
 
 	CreatedAt time.Time
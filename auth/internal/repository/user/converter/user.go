@@ -3,6 +3,7 @@ package converter
 import (
 	"github.com/M1steryO/RelocatorEvents/auth/internal/domain/user"
 	modelRepo "github.com/M1steryO/RelocatorEvents/auth/internal/repository/user/model"
+	"github.com/M1steryO/RelocatorEvents/auth/internal/utils/storage"
 	"time"
 )
 
@@ -36,6 +37,7 @@ func ToUserInfoFromRepo(userInfo *modelRepo.UserInfo) user.UserInfo {
 func ToUserFromRepo(u *modelRepo.User) *user.User {
 	return &user.User{
 		ID:        u.Id,
+		Role:      storage.GetRoleById(u.Role),
 		Info:      ToUserInfoFromRepo(u.Info),
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: func() *time.Time {
@@ -7,6 +7,7 @@ import (
 
 type User struct {
 	Id   int64     `db:"id"`
+	Role int       `db:"role"`
 	Info *UserInfo `db:""`
 
 	CreatedAt time.Time    `db:"created_at"`
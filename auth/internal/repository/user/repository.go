@@ -29,7 +29,7 @@ func (s *repo) Get(ctx context.Context, id int64) (*modelDomain.User, error) {
 	user := modelRepo.User{}
 	q := db.Query{
 		Title: "user_repository.Get",
-		Query: `SELECT id, name,telegram_id,email,tg_username,country, city
+		Query: `SELECT id, role, name,telegram_id,email,tg_username,country, city
 				 FROM "users"
 				 JOIN user_data ON users.id = user_data.user_id
 				 
@@ -58,7 +58,7 @@ func (s *repo) GetByTelegramId(ctx context.Context, telegramId int64) (*modelDom
 	user := modelRepo.User{}
 	q := db.Query{
 		Title: "user_repository.GetByTelegramId",
-		Query: `SELECT id, name,telegram_id,email,tg_username,country, city
+		Query: `SELECT id, role, name,telegram_id,email,tg_username,country, city
 				 FROM "users"
 				 JOIN user_data ON users.id = user_data.user_id
 				 
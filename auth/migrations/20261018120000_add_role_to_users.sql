@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 0 - USER, 1 - ADMIN (см. internal/utils/storage/role.go)
+alter table users
+    add column role smallint not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table users
+    drop column role;
+-- +goose StatementEnd
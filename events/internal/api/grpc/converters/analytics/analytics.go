@@ -0,0 +1,77 @@
+package analytics
+
+import (
+	"fmt"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"strconv"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+func ViewSourceToDomainFromApi(source desc.VIEW_SOURCE) domain.ViewSource {
+	if source == desc.VIEW_SOURCE_view_source_unspecified {
+		return domain.SourceUnknown
+	}
+	return domain.ViewSource(source.String())
+}
+
+func StatsParamsToDomainFromApi(req *desc.GetEventAnalyticsRequest) (*domain.StatsParams, error) {
+	params := &domain.StatsParams{}
+
+	set := 0
+	if req.EventId != nil {
+		params.Dimension, params.Key = domain.DimensionEvent, strconv.FormatInt(req.EventId.Value, 10)
+		set++
+	}
+	if req.City != nil {
+		params.Dimension, params.Key = domain.DimensionCity, req.City.Value
+		set++
+	}
+	if req.Category != nil {
+		params.Dimension, params.Key = domain.DimensionCategory, req.Category.Value
+		set++
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("%w: exactly one of event_id, city or category is required", domain.ErrInvalidStatsParams)
+	}
+
+	from, err := time.Parse(dateLayout, req.DateFrom)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid date_from %q", domain.ErrInvalidStatsParams, req.DateFrom)
+	}
+	to, err := time.Parse(dateLayout, req.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid date_to %q", domain.ErrInvalidStatsParams, req.DateTo)
+	}
+	params.From, params.To = from, to.AddDate(0, 0, 1)
+
+	return params, nil
+}
+
+func DailyStatsToApiFromService(stats *domain.DailyStats) *desc.ViewStats {
+	if stats == nil {
+		return nil
+	}
+	return &desc.ViewStats{
+		Date:          stats.Day.Format(dateLayout),
+		Views:         stats.Views,
+		UniqueViewers: stats.UniqueViewers,
+		Favorites:     stats.Favorites,
+		Impressions:   stats.Impressions,
+		ListViews:     stats.ListViews,
+		Ctr:           stats.CTR(),
+	}
+}
+
+func StatsToApiFromService(stats *domain.Stats) *desc.GetEventAnalyticsResponse {
+	days := make([]*desc.ViewStats, 0, len(stats.Days))
+	for _, d := range stats.Days {
+		days = append(days, DailyStatsToApiFromService(d))
+	}
+	return &desc.GetEventAnalyticsResponse{
+		Total: DailyStatsToApiFromService(stats.Total),
+		Days:  days,
+	}
+}
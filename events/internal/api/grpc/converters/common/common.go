@@ -63,3 +63,25 @@ func ToFloatFromFloat64(num *float64) *wrapperspb.FloatValue {
 		Value: float32(*num),
 	}
 }
+
+func ToFloat64FromDoubleValue(num *wrapperspb.DoubleValue) *float64 {
+	if num == nil {
+		return nil
+	}
+	return &num.Value
+}
+
+func ToDoubleValueFromFloat64(num *float64) *wrapperspb.DoubleValue {
+	if num == nil {
+		return nil
+	}
+	return &wrapperspb.DoubleValue{Value: *num}
+}
+
+func ToFloat64FromFloatValue(num *wrapperspb.FloatValue) *float64 {
+	if num == nil {
+		return nil
+	}
+	f := float64(num.Value)
+	return &f
+}
@@ -1,6 +1,7 @@
 package events
 
 import (
+	"fmt"
 	"github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/common"
 	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
 	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
@@ -41,80 +42,166 @@ func EventToApiFromService(event *domain.Event) *desc.Event {
 		ReviewsCount:   common.ToInt32ValueFromInt32(event.ReviewsCount),
 		MinAge:         common.ToInt32ValueFromInt32(event.MinAge),
 		SeatsAvailable: common.ToInt32ValueFromInt32(event.SeatsAvailable),
-		MinPrice:       common.ToInt32ValueFromInt32(event.MinPrice),
+		MinPrice:       common.ToInt32ValueFromInt32(domain.MajorFromMinor(event.MinPrice, false)),
+		MaxPrice:       common.ToInt32ValueFromInt32(domain.MajorFromMinor(event.MaxPrice, true)),
+		IsFree:         event.IsFree,
 		Currency:       common.ToStringValueFromString(event.Currency),
+		FavoritesCount: event.FavoritesCount,
+		IsFavorite:     event.IsFavorite,
+		GoingCount:     event.GoingCount,
 
 		Address: EventAddressToApiFromService(event.Address),
 
 		EventType: desc.EVENT_TYPE(event.Type),
 		StartsAt:  common.TimeToProto(&event.StartsAt),
 		ImageUrl:  common.ToStringValueFromString(event.ImageUrl),
+		Highlight: common.ToStringValueFromString(event.Highlight),
+		DistanceM: common.ToDoubleValueFromFloat64(event.DistanceM),
 		CreatedAt: common.TimeToProto(&event.CreatedAt),
 		UpdatedAt: common.TimeToProto(event.UpdatedAt),
+		Sessions:  EventSessionsToApiFromService(event.Sessions),
+
+		RecommendationScore: func() *wrapperspb.DoubleValue {
+			if event.Recommendation != nil {
+				return wrapperspb.Double(event.Recommendation.Score)
+			}
+			return nil
+		}(),
+		RecommendationReasons: func() []string {
+			if event.Recommendation != nil {
+				return event.Recommendation.Reasons
+			}
+			return nil
+		}(),
+	}
+}
+
+func EventSessionsToApiFromService(sessions []*domain.EventSession) []*desc.EventSession {
+	if len(sessions) == 0 {
+		return nil
+	}
+	result := make([]*desc.EventSession, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, &desc.EventSession{
+			Id:             s.Id,
+			StartsAt:       common.TimeToProto(&s.StartsAt),
+			EndsAt:         common.TimeToProto(s.EndsAt),
+			MinPrice:       common.ToInt32ValueFromInt32(domain.MajorFromMinor(s.MinPrice, false)),
+			MaxPrice:       common.ToInt32ValueFromInt32(domain.MajorFromMinor(s.MaxPrice, true)),
+			Currency:       common.ToStringValueFromString(s.Currency),
+			SeatsAvailable: common.ToInt32ValueFromInt32(s.SeatsAvailable),
+		})
+	}
+	return result
+}
+
+func eventSessionsToServiceFromApi(sessions []*desc.EventSessionInfo) []*domain.EventSession {
+	if len(sessions) == 0 {
+		return nil
+	}
+	result := make([]*domain.EventSession, 0, len(sessions))
+	for _, s := range sessions {
+		session := &domain.EventSession{
+			StartsAt:       s.StartsAt.AsTime(),
+			MinPrice:       domain.MinorFromMajor(common.ToInt32FromInt32Value(s.MinPrice)),
+			MaxPrice:       domain.MinorFromMajor(common.ToInt32FromInt32Value(s.MaxPrice)),
+			Currency:       common.ToStringFromStringValue(s.Currency),
+			SeatsAvailable: common.ToInt32FromInt32Value(s.SeatsAvailable),
+		}
+		if s.EndsAt != nil {
+			endsAt := s.EndsAt.AsTime()
+			session.EndsAt = &endsAt
+		}
+		result = append(result, session)
+	}
+	return result
+}
+
+const dateLayout = "2006-01-02"
+
+func parseDate(value *wrapperspb.StringValue, field string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	date, err := time.Parse(dateLayout, value.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid %s %q", domain.ErrInvalidDateFilter, field, value.Value)
+	}
+	return &date, nil
+}
+
+func eventDateToDomainFromApi(eventDate, dateFrom, dateTo *wrapperspb.StringValue) (*domain.EventDate, error) {
+	if eventDate != nil {
+		if dateFrom != nil || dateTo != nil {
+			return nil, fmt.Errorf("%w: event_date can't be combined with date_from/date_to", domain.ErrInvalidDateFilter)
+		}
+
+		date, err := time.Parse(dateLayout, eventDate.Value)
+		if err != nil {
+			preset := domain.Preset(eventDate.Value)
+			return &domain.EventDate{
+				Preset: &preset,
+			}, nil
+		}
+		return &domain.EventDate{
+			Date: &date,
+		}, nil
+	}
+
+	if dateFrom == nil && dateTo == nil {
+		return nil, nil
+	}
+
+	from, err := parseDate(dateFrom, "date_from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseDate(dateTo, "date_to")
+	if err != nil {
+		return nil, err
 	}
+	return &domain.EventDate{
+		From: from,
+		To:   to,
+	}, nil
 }
 
-func SearchParamsToDomainFromApi(params *desc.ListEventsRequest) *domain.SearchParams {
+func eventTypeToDomainFromApi(eventType *desc.EVENT_TYPE) *domain.EventType {
+	if eventType != nil {
+		e := domain.EventType(*eventType)
+		return &e
+	}
+	return nil
+}
+
+func SearchParamsToDomainFromApi(params *desc.ListEventsRequest) (*domain.SearchParams, error) {
+	eventDate, err := eventDateToDomainFromApi(params.EventDate, params.DateFrom, params.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
 	return &domain.SearchParams{
 		Q:        common.ToStringFromStringValue(params.Q),
 		Sort:     common.ToStringFromStringValue(params.Sort),
 		City:     common.ToStringFromStringValue(params.City),
 		District: common.ToStringFromStringValue(params.District),
 
-		MinPrice: common.ToInt32FromInt32Value(params.MinPrice),
-		MaxPrice: common.ToInt32FromInt32Value(params.MaxPrice),
-
-		EventDate: func() *domain.EventDate {
-			if params.EventDate != nil {
-				date, err := time.Parse("2006-01-02", params.EventDate.Value)
-				if err != nil {
-					switch params.EventDate.Value {
-					case "today":
-						preset := domain.PresetToday
-						return &domain.EventDate{
-							Preset: &preset,
-						}
-					case "tomorrow":
-						preset := domain.PresetTomorrow
-						return &domain.EventDate{
-							Preset: &preset,
-						}
-					case "weekends":
-						preset := domain.PresetWeekends
-						return &domain.EventDate{
-							Preset: &preset,
-						}
-					case "weekdays":
-						preset := domain.PresetWeekdays
-						return &domain.EventDate{
-							Preset: &preset,
-						}
-					default:
-						return nil
-					}
-				}
-				return &domain.EventDate{
-					Date: &date,
-				}
+		Lat:      common.ToFloat64FromDoubleValue(params.Lat),
+		Lon:      common.ToFloat64FromDoubleValue(params.Lon),
+		RadiusKm: common.ToFloat64FromDoubleValue(params.RadiusKm),
 
-			}
-			return nil
-		}(),
+		MinPrice: domain.MinorFromMajor(common.ToInt32FromInt32Value(params.MinPrice)),
+		MaxPrice: domain.MinorFromMajor(common.ToInt32FromInt32Value(params.MaxPrice)),
+		Currency: common.ToStringFromStringValue(params.Currency),
 
-		EventType: func() *domain.EventType {
-			if params.EventType != nil {
-				e := domain.EventType(*params.EventType)
-				return &e
-			}
-			return nil
+		EventDate: eventDate,
 
-		}(),
+		EventType:  eventTypeToDomainFromApi(params.EventType),
 		Categories: params.Category,
 
 		Limit:  common.ToInt64FromInt64Value(params.Limit),
-		LastID: common.ToInt64FromInt64Value(params.LastId),
-		Offset: common.ToInt64FromInt64Value(params.Offset),
-	}
+		Cursor: common.ToStringFromStringValue(params.Cursor),
+	}, nil
 }
 
 func EventListToApiFromService(events []*domain.Event) []*desc.Event {
@@ -141,9 +228,215 @@ func EventCategoriesFromDomainToApi(cats []*domain.EventCategory) []*desc.EventC
 
 func FiltersToApiFromService(filters *domain.FiltersData) *desc.FiltersValues {
 	return &desc.FiltersValues{
-		MinPrice:   common.ToInt32ValueFromInt32(filters.MinPrice),
-		MaxPrice:   common.ToInt32ValueFromInt32(filters.MaxPrice),
+		MinPrice:   common.ToInt32ValueFromInt32(domain.MajorFromMinor(filters.MinPrice, false)),
+		MaxPrice:   common.ToInt32ValueFromInt32(domain.MajorFromMinor(filters.MaxPrice, true)),
 		Cities:     filters.Cities,
 		Categories: EventCategoriesFromDomainToApi(filters.Categories),
+		Currency:   filters.Currency,
+	}
+}
+
+func EventAddressToServiceFromApi(address *desc.EventAddress) *domain.EventAddress {
+	if address == nil {
+		return nil
+	}
+	return &domain.EventAddress{
+		VenueName:   common.ToStringFromStringValue(address.VenueName),
+		FullAddress: address.FullAddress,
+		Country:     address.Country,
+		City:        address.City,
+		District:    common.ToStringFromStringValue(address.District),
+		PostalCode:  common.ToStringFromStringValue(address.PostalCode),
+		Latitude:    common.ToFloat64FromFloatValue(address.Latitude),
+		Longitude:   common.ToFloat64FromFloatValue(address.Longitude),
+	}
+}
+
+func EventToServiceFromApi(id int64, info *desc.EventInfo) *domain.Event {
+	return &domain.Event{
+		Id:          id,
+		Title:       info.Title,
+		Description: common.ToStringFromStringValue(info.Description),
+		Link:        info.Link,
+
+		MinAge:     common.ToInt32FromInt32Value(info.MinAge),
+		SeatsTotal: common.ToInt32FromInt32Value(info.SeatsAvailable),
+		MinPrice:   domain.MinorFromMajor(common.ToInt32FromInt32Value(info.MinPrice)),
+		MaxPrice:   domain.MinorFromMajor(common.ToInt32FromInt32Value(info.MaxPrice)),
+		IsFree:     info.IsFree,
+		Currency:   common.ToStringFromStringValue(info.Currency),
+
+		Type:     domain.EventType(info.EventType),
+		StartsAt: info.StartsAt.AsTime(),
+		ImageUrl: common.ToStringFromStringValue(info.ImageUrl),
+		Address:  EventAddressToServiceFromApi(info.Address),
+		Sessions: eventSessionsToServiceFromApi(info.Sessions),
+	}
+}
+
+func suggestionTypeToApiFromService(t domain.SuggestionType) desc.SUGGESTION_TYPE {
+	switch t {
+	case domain.SuggestionTypeVenue:
+		return desc.SUGGESTION_TYPE_venue
+	case domain.SuggestionTypeCity:
+		return desc.SUGGESTION_TYPE_city
+	case domain.SuggestionTypeCategory:
+		return desc.SUGGESTION_TYPE_category
+	}
+	return desc.SUGGESTION_TYPE_event
+}
+
+func SuggestionsToApiFromService(suggestions []*domain.Suggestion) []*desc.Suggestion {
+	result := make([]*desc.Suggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		result = append(result, &desc.Suggestion{
+			Type: suggestionTypeToApiFromService(s.Type),
+			Text: s.Text,
+			EventId: func() *wrapperspb.Int64Value {
+				if s.EventId != nil {
+					return wrapperspb.Int64(*s.EventId)
+				}
+				return nil
+			}(),
+			Code:  common.ToStringValueFromString(s.Code),
+			Score: s.Score,
+		})
+	}
+	return result
+}
+
+func MapRequestToDomainFromApi(req *desc.EventsMapRequest) (*domain.SearchParams, *domain.MapViewport, error) {
+	eventDate, err := eventDateToDomainFromApi(req.EventDate, req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := &domain.SearchParams{
+		Q:        common.ToStringFromStringValue(req.Q),
+		City:     common.ToStringFromStringValue(req.City),
+		District: common.ToStringFromStringValue(req.District),
+
+		MinPrice: domain.MinorFromMajor(common.ToInt32FromInt32Value(req.MinPrice)),
+		MaxPrice: domain.MinorFromMajor(common.ToInt32FromInt32Value(req.MaxPrice)),
+		Currency: common.ToStringFromStringValue(req.Currency),
+
+		EventDate:  eventDate,
+		EventType:  eventTypeToDomainFromApi(req.EventType),
+		Categories: req.Category,
+	}
+
+	return params, &domain.MapViewport{
+		MinLat: req.MinLat,
+		MinLon: req.MinLon,
+		MaxLat: req.MaxLat,
+		MaxLon: req.MaxLon,
+		Zoom:   req.Zoom,
+	}, nil
+}
+
+func MapClustersToApiFromService(clusters []*domain.MapCluster) []*desc.MapCluster {
+	result := make([]*desc.MapCluster, 0, len(clusters))
+	for _, c := range clusters {
+		result = append(result, &desc.MapCluster{
+			Count:     c.Count,
+			Latitude:  c.Latitude,
+			Longitude: c.Longitude,
+			SampleIds: c.SampleIds,
+		})
+	}
+	return result
+}
+
+func CategoryAliasesToApiFromService(aliases []*domain.CategoryAlias) []*desc.CategoryAlias {
+	result := make([]*desc.CategoryAlias, 0, len(aliases))
+	for _, a := range aliases {
+		result = append(result, &desc.CategoryAlias{
+			Alias: a.Alias,
+			Category: &desc.EventCategory{
+				Title: a.Category.Title,
+				Code:  a.Category.Code,
+			},
+		})
+	}
+	return result
+}
+
+func CurrencyRatesToApiFromService(rates []*domain.CurrencyRate) []*desc.CurrencyRate {
+	result := make([]*desc.CurrencyRate, 0, len(rates))
+	for _, r := range rates {
+		result = append(result, &desc.CurrencyRate{
+			Currency:  r.Currency,
+			Rate:      r.Rate,
+			UpdatedAt: common.TimeToProto(&r.UpdatedAt),
+		})
+	}
+	return result
+}
+
+func FavoritesParamsToDomainFromApi(req *desc.ListFavoritesRequest) *domain.FavoritesParams {
+	return &domain.FavoritesParams{
+		IncludePast: req.IncludePast,
+		Limit:       common.ToInt64FromInt64Value(req.Limit),
+		Cursor:      common.ToStringFromStringValue(req.Cursor),
+	}
+}
+
+// значения RSVP_STATUS называются так же, как статусы в домене
+func RsvpStatusToDomainFromApi(status desc.RSVP_STATUS) domain.RsvpStatus {
+	if status == desc.RSVP_STATUS_rsvp_unspecified {
+		return ""
+	}
+	return domain.RsvpStatus(status.String())
+}
+
+func RsvpStatusToApiFromService(status domain.RsvpStatus) desc.RSVP_STATUS {
+	return desc.RSVP_STATUS(desc.RSVP_STATUS_value[string(status)])
+}
+
+func RsvpParamsToDomainFromApi(req *desc.ListRsvpsRequest) *domain.RsvpParams {
+	params := &domain.RsvpParams{
+		Limit:  common.ToInt64FromInt64Value(req.Limit),
+		Cursor: common.ToStringFromStringValue(req.Cursor),
+	}
+	for _, status := range req.Status {
+		if s := RsvpStatusToDomainFromApi(status); s != "" {
+			params.Statuses = append(params.Statuses, s)
+		}
+	}
+	return params
+}
+
+func RsvpsToApiFromService(rsvps []*domain.Rsvp) []*desc.Rsvp {
+	result := make([]*desc.Rsvp, 0, len(rsvps))
+	for _, r := range rsvps {
+		result = append(result, &desc.Rsvp{
+			Status:    RsvpStatusToApiFromService(r.Status),
+			UpdatedAt: common.TimeToProto(&r.UpdatedAt),
+			Event:     EventToApiFromService(r.Event),
+		})
+	}
+	return result
+}
+
+func TrendingEventsToApiFromService(events []*domain.TrendingEvent) []*desc.TrendingEvent {
+	result := make([]*desc.TrendingEvent, 0, len(events))
+	for _, e := range events {
+		result = append(result, &desc.TrendingEvent{
+			Event:     EventToApiFromService(e.Event),
+			Score:     e.Score,
+			Views:     e.Views,
+			Favorites: e.Favorites,
+			Rsvps:     e.Rsvps,
+			Reviews:   e.Reviews,
+		})
+	}
+	return result
+}
+
+func CalendarFeedToApiFromService(feed *domain.CalendarFeed) *desc.CalendarFeed {
+	return &desc.CalendarFeed{
+		HttpUrl:   feed.HttpURL,
+		WebcalUrl: feed.WebcalURL,
+		CreatedAt: common.TimeToProto(&feed.CreatedAt),
 	}
 }
@@ -0,0 +1,20 @@
+package reminders
+
+import (
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+)
+
+func SettingsToDomainFromApi(settings *desc.ReminderSettings) *domain.Settings {
+	return &domain.Settings{
+		HoursBefore: settings.GetHoursBefore(),
+		Enabled:     settings.GetEnabled(),
+	}
+}
+
+func SettingsToApiFromService(settings *domain.Settings) *desc.ReminderSettings {
+	return &desc.ReminderSettings{
+		HoursBefore: settings.HoursBefore,
+		Enabled:     settings.Enabled,
+	}
+}
@@ -2,6 +2,7 @@ package reviews
 
 import (
 	"fmt"
+	"github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/common"
 	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
 	desc "github.com/M1steryO/RelocatorEvents/events/pkg/reviews_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -62,6 +63,11 @@ func ReviewToProto(r *domain.Review) *desc.Review {
 		})
 	}
 
+	var editedAt *timestamppb.Timestamp
+	if r.EditedAt != nil {
+		editedAt = timestamppb.New(*r.EditedAt)
+	}
+
 	return &desc.Review{
 		Grade:         int32(r.Grade),
 		Advantages:    r.Advantages,
@@ -70,6 +76,9 @@ func ReviewToProto(r *domain.Review) *desc.Review {
 		Media:         media,
 		AuthorId:      r.AuthorId,
 		CreatedAt:     timestamppb.New(r.CreatedAt),
+		Id:            r.Id,
+		EditedAt:      editedAt,
+		HelpfulCount:  r.HelpfulCount,
 	}
 }
 
@@ -96,3 +105,27 @@ func ReviewsToProto(in []*domain.Review) []*desc.Review {
 	}
 	return out
 }
+
+func ListParamsFromProto(req *desc.ListReviewsRequest) *domain.ListParams {
+	params := &domain.ListParams{
+		EventId:    req.GetEventId(),
+		WithPhotos: req.GetWithPhotos(),
+		Limit:      common.ToInt64FromInt64Value(req.GetLimit()),
+		Cursor:     common.ToStringFromStringValue(req.GetCursor()),
+	}
+	if sort := common.ToStringFromStringValue(req.GetSort()); sort != nil {
+		params.Sort = *sort
+	}
+	return params
+}
+
+func HistogramToProto(histogram domain.Histogram) []*desc.GradeCount {
+	out := make([]*desc.GradeCount, 0, len(histogram))
+	for i, count := range histogram {
+		out = append(out, &desc.GradeCount{
+			Grade: int32(i + domain.MinGrade),
+			Count: count,
+		})
+	}
+	return out
+}
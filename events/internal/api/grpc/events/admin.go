@@ -0,0 +1,17 @@
+package events
+
+import (
+	"context"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+)
+
+const adminRole = "ADMIN"
+
+func checkAdmin(ctx context.Context) error {
+	role, ok := ctx.Value("userRole").(string)
+	if !ok || role != adminRole {
+		return sys.NewCommonError("permission denied", codes.PermissionDenied)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/analytics"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"log/slog"
+)
+
+func (i *EventsImplementation) GetEventAnalytics(ctx context.Context, req *desc.GetEventAnalyticsRequest) (*desc.GetEventAnalyticsResponse, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	params, err := converter.StatsParamsToDomainFromApi(req)
+	if err != nil {
+		return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+	}
+
+	stats, err := i.service.GetViewStats(ctx, params)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidStatsParams) {
+			return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+		}
+		logger.Error("error getting event analytics", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error getting event analytics", codes.Internal)
+	}
+
+	return converter.StatsToApiFromService(stats), nil
+}
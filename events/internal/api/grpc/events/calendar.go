@@ -0,0 +1,66 @@
+package events
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/utils/ical"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log/slog"
+)
+
+func (i *EventsImplementation) ExportEventIcs(ctx context.Context, req *desc.ExportEventIcsRequest) (*httpbody.HttpBody, error) {
+	data, err := i.service.ExportEventIcs(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, sys.NewCommonError(domain.ErrEventNotFound.Error(), codes.NotFound)
+		}
+		logger.Error("error exporting event to ics", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error exporting event", codes.Internal)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: ical.ContentType,
+		Data:        data,
+	}, nil
+}
+
+func (i *EventsImplementation) GetCalendarFeed(ctx context.Context, _ *emptypb.Empty) (*desc.CalendarFeed, error) {
+	feed, err := i.service.GetCalendarFeed(ctx)
+	if err != nil {
+		logger.Error("error getting calendar feed", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error getting calendar feed", codes.Internal)
+	}
+	return converter.CalendarFeedToApiFromService(feed), nil
+}
+
+func (i *EventsImplementation) ResetCalendarFeed(ctx context.Context, _ *emptypb.Empty) (*desc.CalendarFeed, error) {
+	feed, err := i.service.ResetCalendarFeed(ctx)
+	if err != nil {
+		logger.Error("error resetting calendar feed", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error resetting calendar feed", codes.Internal)
+	}
+	return converter.CalendarFeedToApiFromService(feed), nil
+}
+
+func (i *EventsImplementation) GetCalendarFeedIcs(ctx context.Context, req *desc.GetCalendarFeedIcsRequest) (*httpbody.HttpBody, error) {
+	data, err := i.service.GetCalendarFeedIcs(ctx, req.GetToken())
+	if err != nil {
+		if errors.Is(err, domain.ErrCalendarFeedNotFound) {
+			return nil, sys.NewCommonError(domain.ErrCalendarFeedNotFound.Error(), codes.NotFound)
+		}
+		logger.Error("error building calendar feed", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error building calendar feed", codes.Internal)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: ical.ContentType,
+		Data:        data,
+	}, nil
+}
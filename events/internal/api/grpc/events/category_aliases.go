@@ -0,0 +1,64 @@
+package events
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log/slog"
+)
+
+func (i *EventsImplementation) ListCategoryAliases(ctx context.Context, _ *emptypb.Empty) (*desc.ListCategoryAliasesResponse, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	aliases, err := i.service.ListCategoryAliases(ctx)
+	if err != nil {
+		logger.Error("error listing category aliases", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error listing category aliases", codes.Internal)
+	}
+
+	return &desc.ListCategoryAliasesResponse{
+		Aliases: converter.CategoryAliasesToApiFromService(aliases),
+	}, nil
+}
+
+func (i *EventsImplementation) SetCategoryAlias(ctx context.Context, req *desc.SetCategoryAliasRequest) (*emptypb.Empty, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	err := i.service.SetCategoryAlias(ctx, req.GetAlias(), req.GetCategoryCode())
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			return nil, sys.NewCommonError(domain.ErrCategoryNotFound.Error(), codes.NotFound)
+		}
+		logger.Error("error setting category alias", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error setting category alias", codes.Internal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *EventsImplementation) DeleteCategoryAlias(ctx context.Context, req *desc.DeleteCategoryAliasRequest) (*emptypb.Empty, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	err := i.service.DeleteCategoryAlias(ctx, req.GetAlias())
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryAliasNotFound) {
+			return nil, sys.NewCommonError(domain.ErrCategoryAliasNotFound.Error(), codes.NotFound)
+		}
+		logger.Error("error deleting category alias", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error deleting category alias", codes.Internal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
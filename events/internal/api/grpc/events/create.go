@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"log/slog"
+)
+
+func (i *EventsImplementation) CreateEvent(ctx context.Context, req *desc.CreateEventRequest) (*desc.CreateEventResponse, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := i.service.Create(ctx, converter.EventToServiceFromApi(0, req.GetEvent()), req.GetCategories())
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSession) || errors.Is(err, domain.ErrInvalidPriceRange) {
+			return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+		}
+		if errors.Is(err, domain.ErrEventExists) {
+			return nil, sys.NewCommonError(domain.ErrEventExists.Error(), codes.AlreadyExists)
+		}
+		logger.Error("error creating event", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error creating event", codes.Internal)
+	}
+
+	return &desc.CreateEventResponse{
+		Id: id,
+	}, nil
+}
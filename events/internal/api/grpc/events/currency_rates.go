@@ -0,0 +1,49 @@
+package events
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log/slog"
+)
+
+func (i *EventsImplementation) ListCurrencyRates(ctx context.Context, _ *emptypb.Empty) (*desc.ListCurrencyRatesResponse, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	rates, err := i.service.ListCurrencyRates(ctx)
+	if err != nil {
+		logger.Error("error listing currency rates", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error listing currency rates", codes.Internal)
+	}
+
+	return &desc.ListCurrencyRatesResponse{
+		Rates: converter.CurrencyRatesToApiFromService(rates),
+	}, nil
+}
+
+func (i *EventsImplementation) ImportCurrencyRates(ctx context.Context, req *desc.ImportCurrencyRatesRequest) (*desc.ImportCurrencyRatesResponse, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	imported, err := i.service.ImportCurrencyRates(ctx, req.GetFile())
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCurrencyRates) {
+			return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+		}
+		logger.Error("error importing currency rates", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error importing currency rates", codes.Internal)
+	}
+
+	return &desc.ImportCurrencyRatesResponse{
+		Imported: imported,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/common"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log/slog"
+)
+
+func (i *EventsImplementation) AddFavorite(ctx context.Context, req *desc.FavoriteRequest) (*emptypb.Empty, error) {
+	err := i.service.AddFavorite(ctx, req.GetEventId())
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, sys.NewCommonError(domain.ErrEventNotFound.Error(), codes.NotFound)
+		}
+		logger.Error("error adding favorite", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error adding favorite", codes.Internal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *EventsImplementation) RemoveFavorite(ctx context.Context, req *desc.FavoriteRequest) (*emptypb.Empty, error) {
+	err := i.service.RemoveFavorite(ctx, req.GetEventId())
+	if err != nil {
+		logger.Error("error removing favorite", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error removing favorite", codes.Internal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *EventsImplementation) ListFavorites(ctx context.Context, req *desc.ListFavoritesRequest) (*desc.ListFavoritesResponse, error) {
+	list, err := i.service.ListFavorites(ctx, converter.FavoritesParamsToDomainFromApi(req))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCursor) {
+			return nil, sys.NewCommonError(domain.ErrInvalidCursor.Error(), codes.InvalidArgument)
+		}
+		logger.Error("error listing favorites", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error listing favorites", codes.Internal)
+	}
+
+	return &desc.ListFavoritesResponse{
+		Data:       converter.EventListToApiFromService(list.Data),
+		NextCursor: common.ToStringValueFromString(list.NextCursor),
+	}, nil
+}
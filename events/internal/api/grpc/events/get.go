@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"errors"
+	analyticsConverter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/analytics"
 	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
 	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
 	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
@@ -19,7 +20,7 @@ func (i *EventsImplementation) GetEvent(ctx context.Context, req *desc.GetReques
 	}
 	logger.Info("Received", slog.Int64("id:", req.GetId()))
 
-	event, err := i.service.Get(ctx, req.GetId())
+	event, err := i.service.Get(ctx, req.GetId(), analyticsConverter.ViewSourceToDomainFromApi(req.GetSource()))
 	if err != nil {
 		if errors.Is(err, domain.ErrEventNotFound) {
 			return nil, sys.NewCommonError(domain.ErrEventNotFound.Error(), codes.NotFound)
@@ -0,0 +1,37 @@
+package events
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"log/slog"
+)
+
+func (i *EventsImplementation) GetEventsMap(ctx context.Context, req *desc.EventsMapRequest) (*desc.EventsMapResponse, error) {
+	if req.GetMinLat() > req.GetMaxLat() {
+		return nil, sys.NewCommonError("min_lat must not exceed max_lat", codes.InvalidArgument)
+	}
+
+	params, viewport, err := converter.MapRequestToDomainFromApi(req)
+	if err != nil {
+		return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+	}
+
+	clusters, err := i.service.GetMap(ctx, params, viewport)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidDateFilter) {
+			return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+		}
+		logger.Error("error getting events map", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error getting events map", codes.Internal)
+	}
+
+	return &desc.EventsMapResponse{
+		Clusters: converter.MapClustersToApiFromService(clusters),
+	}, nil
+}
@@ -2,17 +2,59 @@ package events
 
 import (
 	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/common"
 	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
 	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
 	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
 	"github.com/M1steryO/platform_common/pkg/sys"
 	"github.com/M1steryO/platform_common/pkg/sys/codes"
 	"log/slog"
 )
 
+func validateLocation(req *desc.ListEventsRequest) error {
+	if (req.Lat == nil) != (req.Lon == nil) {
+		return sys.NewCommonError("lat and lon must be set together", codes.InvalidArgument)
+	}
+	if req.Lat != nil && (req.Lat.Value < -90 || req.Lat.Value > 90) {
+		return sys.NewCommonError("invalid lat", codes.InvalidArgument)
+	}
+	if req.Lon != nil && (req.Lon.Value < -180 || req.Lon.Value > 180) {
+		return sys.NewCommonError("invalid lon", codes.InvalidArgument)
+	}
+	if req.RadiusKm != nil {
+		if req.Lat == nil {
+			return sys.NewCommonError("radius_km requires lat and lon", codes.InvalidArgument)
+		}
+		if req.RadiusKm.Value <= 0 {
+			return sys.NewCommonError("invalid radius_km", codes.InvalidArgument)
+		}
+	}
+	return nil
+}
+
 func (i *EventsImplementation) ListEvents(ctx context.Context, req *desc.ListEventsRequest) (*desc.ListEventsResponse, error) {
-	list, err := i.service.GetList(ctx, converter.SearchParamsToDomainFromApi(req))
+	if err := validateLocation(req); err != nil {
+		return nil, err
+	}
+
+	params, err := converter.SearchParamsToDomainFromApi(req)
+	if err != nil {
+		return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+	}
+
+	list, err := i.service.GetList(ctx, params)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCursor) {
+			return nil, sys.NewCommonError(domain.ErrInvalidCursor.Error(), codes.InvalidArgument)
+		}
+		if errors.Is(err, domain.ErrStaleCursor) {
+			return nil, sys.NewCommonError(domain.ErrStaleCursor.Error(), codes.FailedPrecondition)
+		}
+		if errors.Is(err, domain.ErrInvalidDateFilter) || errors.Is(err, domain.ErrInvalidSort) {
+			return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+		}
 		logger.Error("error getting events list", slog.String("err", err.Error()))
 		return nil, sys.NewCommonError("error getting events list", codes.Internal)
 	}
@@ -20,5 +62,7 @@ func (i *EventsImplementation) ListEvents(ctx context.Context, req *desc.ListEve
 	return &desc.ListEventsResponse{
 		Data:    converter.EventListToApiFromService(list.Data),
 		Filters: converter.FiltersToApiFromService(list.Filters),
+
+		NextCursor: common.ToStringValueFromString(list.NextCursor),
 	}, nil
 }
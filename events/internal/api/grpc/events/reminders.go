@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/reminders"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log/slog"
+)
+
+func (i *EventsImplementation) GetReminderSettings(ctx context.Context, _ *emptypb.Empty) (*desc.ReminderSettings, error) {
+	settings, err := i.reminders.GetSettings(ctx)
+	if err != nil {
+		logger.Error("error getting reminder settings", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error getting reminder settings", codes.Internal)
+	}
+	return converter.SettingsToApiFromService(settings), nil
+}
+
+func (i *EventsImplementation) UpdateReminderSettings(ctx context.Context, req *desc.ReminderSettings) (*desc.ReminderSettings, error) {
+	settings, err := i.reminders.UpdateSettings(ctx, converter.SettingsToDomainFromApi(req))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSettings) {
+			return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+		}
+		logger.Error("error updating reminder settings", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error updating reminder settings", codes.Internal)
+	}
+	return converter.SettingsToApiFromService(settings), nil
+}
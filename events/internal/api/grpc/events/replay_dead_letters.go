@@ -0,0 +1,27 @@
+package events
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"log/slog"
+)
+
+func (i *EventsImplementation) ReplayDeadLetters(ctx context.Context, req *desc.ReplayDeadLettersRequest) (*desc.ReplayDeadLettersResponse, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := i.service.ReplayDeadLetters(ctx, req.GetLimit())
+	if err != nil {
+		logger.Error("error replaying dead letters", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error replaying dead letters", codes.Internal)
+	}
+
+	return &desc.ReplayDeadLettersResponse{
+		Replayed: int64(result.Replayed),
+		Skipped:  int64(result.Skipped),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/common"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"log/slog"
+)
+
+func (i *EventsImplementation) SetRsvp(ctx context.Context, req *desc.SetRsvpRequest) (*desc.SetRsvpResponse, error) {
+	status, err := i.service.SetRsvp(ctx, req.GetEventId(), converter.RsvpStatusToDomainFromApi(req.GetStatus()))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRsvpStatus) {
+			return nil, sys.NewCommonError(domain.ErrInvalidRsvpStatus.Error(), codes.InvalidArgument)
+		}
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, sys.NewCommonError(domain.ErrEventNotFound.Error(), codes.NotFound)
+		}
+		if errors.Is(err, domain.ErrEventFinished) {
+			return nil, sys.NewCommonError(domain.ErrEventFinished.Error(), codes.FailedPrecondition)
+		}
+		logger.Error("error setting rsvp", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error setting rsvp", codes.Internal)
+	}
+
+	return &desc.SetRsvpResponse{
+		Status: converter.RsvpStatusToApiFromService(status),
+	}, nil
+}
+
+func (i *EventsImplementation) ListRsvps(ctx context.Context, req *desc.ListRsvpsRequest) (*desc.ListRsvpsResponse, error) {
+	rsvps, next, err := i.service.ListRsvps(ctx, converter.RsvpParamsToDomainFromApi(req))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCursor) {
+			return nil, sys.NewCommonError(domain.ErrInvalidCursor.Error(), codes.InvalidArgument)
+		}
+		logger.Error("error listing rsvps", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error listing rsvps", codes.Internal)
+	}
+
+	return &desc.ListRsvpsResponse{
+		Data:       converter.RsvpsToApiFromService(rsvps),
+		NextCursor: common.ToStringValueFromString(next),
+	}, nil
+}
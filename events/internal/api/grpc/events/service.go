@@ -7,11 +7,13 @@ import (
 
 type EventsImplementation struct {
 	desc.UnimplementedEvent_V1Server
-	service service.EventService
+	service   service.EventService
+	reminders service.ReminderService
 }
 
-func NewEventsImplementation(s service.EventService) *EventsImplementation {
+func NewEventsImplementation(s service.EventService, reminders service.ReminderService) *EventsImplementation {
 	return &EventsImplementation{
-		service: s,
+		service:   s,
+		reminders: reminders,
 	}
 }
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log/slog"
+)
+
+func (i *EventsImplementation) SetEventCategories(ctx context.Context, req *desc.SetEventCategoriesRequest) (*emptypb.Empty, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	err := i.service.SetCategories(ctx, req.GetId(), req.GetCategories())
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, sys.NewCommonError(domain.ErrEventNotFound.Error(), codes.NotFound)
+		}
+		logger.Error("error setting event categories", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error setting event categories", codes.Internal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
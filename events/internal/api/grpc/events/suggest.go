@@ -0,0 +1,23 @@
+package events
+
+import (
+	"context"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"log/slog"
+)
+
+func (i *EventsImplementation) SuggestEvents(ctx context.Context, req *desc.SuggestEventsRequest) (*desc.SuggestEventsResponse, error) {
+	suggestions, err := i.service.Suggest(ctx, req.GetQ(), req.GetLimit().GetValue())
+	if err != nil {
+		logger.Error("error getting suggestions", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error getting suggestions", codes.Internal)
+	}
+
+	return &desc.SuggestEventsResponse{
+		Suggestions: converter.SuggestionsToApiFromService(suggestions),
+	}, nil
+}
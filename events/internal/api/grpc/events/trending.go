@@ -0,0 +1,32 @@
+package events
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/common"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"log/slog"
+)
+
+func (i *EventsImplementation) ListTrendingEvents(ctx context.Context, req *desc.ListTrendingEventsRequest) (*desc.ListTrendingEventsResponse, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	events, err := i.service.ListTrending(ctx, &domain.TrendingParams{
+		City:  req.GetCity(),
+		Limit: common.ToInt64FromInt64Value(req.GetLimit()),
+	})
+	if err != nil {
+		logger.Error("error listing trending events", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error listing trending events", codes.Internal)
+	}
+
+	return &desc.ListTrendingEventsResponse{
+		Data: converter.TrendingEventsToApiFromService(events),
+	}, nil
+}
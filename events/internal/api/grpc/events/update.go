@@ -0,0 +1,37 @@
+package events
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
+	"github.com/M1steryO/platform_common/pkg/sys"
+	"github.com/M1steryO/platform_common/pkg/sys/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log/slog"
+)
+
+func (i *EventsImplementation) UpdateEvent(ctx context.Context, req *desc.UpdateEventRequest) (*emptypb.Empty, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	err := i.service.Update(ctx, converter.EventToServiceFromApi(req.GetId(), req.GetEvent()))
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, sys.NewCommonError(domain.ErrEventNotFound.Error(), codes.NotFound)
+		}
+		if errors.Is(err, domain.ErrInvalidSession) || errors.Is(err, domain.ErrInvalidPriceRange) {
+			return nil, sys.NewCommonError(err.Error(), codes.InvalidArgument)
+		}
+		if errors.Is(err, domain.ErrEventExists) {
+			return nil, sys.NewCommonError(domain.ErrEventExists.Error(), codes.AlreadyExists)
+		}
+		logger.Error("error updating event", slog.String("err", err.Error()))
+		return nil, sys.NewCommonError("error updating event", codes.Internal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/reviews"
 	"github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
 	desc "github.com/M1steryO/RelocatorEvents/events/pkg/reviews_v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,16 +16,20 @@ func (impl *ReviewsImplementation) CreateReview(ctx context.Context, req *desc.C
 	if err != nil {
 		return nil, err
 	}
-	//userId, ok := ctx.Value("userId").(int64)
-	//if !ok {
-	//	return nil, errors.New("missing userId")
-	//}
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return nil, errors.New("missing userId")
+	}
 
-	_, err = impl.service.Create(ctx, req.EventId, review.AuthorId, review)
+	_, err = impl.service.Create(ctx, req.EventId, userId, review)
 	if err != nil {
 		if errors.Is(err, events.ErrEventNotFound) {
 			return nil, status.Error(codes.NotFound, "event not found")
 		}
+		// повтор запроса не создаёт второй отзыв и не сдвигает рейтинг
+		if errors.Is(err, domain.ErrReviewExists) {
+			return nil, status.Error(codes.AlreadyExists, "review already exists")
+		}
 		return nil, err
 	}
 
@@ -0,0 +1,15 @@
+package reviews
+
+import (
+	"context"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/reviews_v1"
+)
+
+func (impl *ReviewsImplementation) MarkReviewHelpful(ctx context.Context, req *desc.MarkReviewHelpfulRequest) (*desc.MarkReviewHelpfulResponse, error) {
+	count, err := impl.service.MarkHelpful(ctx, req.GetId(), req.GetHelpful())
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &desc.MarkReviewHelpfulResponse{HelpfulCount: count}, nil
+}
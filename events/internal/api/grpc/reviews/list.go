@@ -2,18 +2,28 @@ package reviews
 
 import (
 	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/common"
 	converters "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/reviews"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
 	desc "github.com/M1steryO/RelocatorEvents/events/pkg/reviews_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (impl *ReviewsImplementation) ListReviews(ctx context.Context, req *desc.ListReviewsRequest) (*desc.ListReviewsResponse, error) {
-	list, err := impl.service.List(ctx, req.GetEventId())
+	list, err := impl.service.List(ctx, converters.ListParamsFromProto(req))
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSort) || errors.Is(err, domain.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 	return &desc.ListReviewsResponse{
 		Rating:       list.EventRating,
 		ReviewsCount: list.ReviewsCount,
 		Reviews:      converters.ReviewsToProto(list.Reviews),
+		NextCursor:   common.ToStringValueFromString(list.NextCursor),
+		Histogram:    converters.HistogramToProto(list.Histogram),
 	}, nil
 }
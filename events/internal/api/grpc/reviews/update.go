@@ -0,0 +1,45 @@
+package reviews
+
+import (
+	"context"
+	"errors"
+	converter "github.com/M1steryO/RelocatorEvents/events/internal/api/grpc/converters/reviews"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/reviews_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (impl *ReviewsImplementation) UpdateReview(ctx context.Context, req *desc.UpdateReviewRequest) (*desc.UpdateReviewResponse, error) {
+	review, err := converter.ReviewFromProto(req.GetReview())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = impl.service.Update(ctx, req.GetId(), review)
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &desc.UpdateReviewResponse{}, nil
+}
+
+func (impl *ReviewsImplementation) DeleteReview(ctx context.Context, req *desc.DeleteReviewRequest) (*desc.DeleteReviewResponse, error) {
+	err := impl.service.Delete(ctx, req.GetId())
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &desc.DeleteReviewResponse{}, nil
+}
+
+func reviewError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrReviewNotFound):
+		return status.Error(codes.NotFound, "review not found")
+	case errors.Is(err, domain.ErrReviewForbidden):
+		return status.Error(codes.PermissionDenied, "only the author can change the review")
+	default:
+		return err
+	}
+}
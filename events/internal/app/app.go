@@ -10,6 +10,7 @@ import (
 	"github.com/M1steryO/RelocatorEvents/events/internal/interceptor"
 	"github.com/M1steryO/RelocatorEvents/events/internal/metric"
 	"github.com/M1steryO/RelocatorEvents/events/internal/middleware"
+	"github.com/M1steryO/RelocatorEvents/events/internal/service"
 	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
 	reviewsDesc "github.com/M1steryO/RelocatorEvents/events/pkg/reviews_v1"
 	"github.com/M1steryO/platform_common/pkg/closer"
@@ -26,6 +27,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 )
@@ -43,6 +45,11 @@ type App struct {
 	promServer      *http.Server
 
 	kafkaConsumer *kafka.Consumer
+	outboxRelay   service.OutboxRelay
+
+	popularityRefresher service.PopularityRefresher
+	viewRecorder        service.ViewRecorder
+	reminderScheduler   service.ReminderScheduler
 }
 
 func NewApp(ctx context.Context) (*App, error) {
@@ -61,7 +68,7 @@ func (a *App) Run() error {
 		closer.Wait()
 	}()
 	wg := sync.WaitGroup{}
-	wg.Add(3)
+	wg.Add(8)
 	go func() {
 		defer wg.Done()
 		err := a.runGRPCServer()
@@ -95,6 +102,58 @@ func (a *App) Run() error {
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+		ctx, cancel := context.WithCancel(context.Background())
+		closer.Add(func() error {
+			cancel()
+			return nil
+		})
+		err := a.runOutboxRelay(ctx)
+		if err != nil {
+			log.Fatal("failed to run outbox relay: ", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		ctx, cancel := context.WithCancel(context.Background())
+		closer.Add(func() error {
+			cancel()
+			return nil
+		})
+		err := a.runPopularityRefresher(ctx)
+		if err != nil {
+			log.Fatal("failed to run popularity refresher: ", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		ctx, cancel := context.WithCancel(context.Background())
+		closer.Add(func() error {
+			cancel()
+			return nil
+		})
+		err := a.viewRecorder.Run(ctx)
+		if err != nil {
+			log.Fatal("failed to run view recorder: ", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		ctx, cancel := context.WithCancel(context.Background())
+		closer.Add(func() error {
+			cancel()
+			return nil
+		})
+		err := a.runReminderScheduler(ctx)
+		if err != nil {
+			log.Fatal("failed to run reminder scheduler: ", err)
+		}
+	}()
+
 	logger.Init(a.serviceProvider.LoggerConfig().Env())
 
 	wg.Wait()
@@ -109,6 +168,10 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initHTTPServer,
 		a.initPrometheus,
 		a.initKafkaConsumer,
+		a.initOutboxRelay,
+		a.initPopularityRefresher,
+		a.initViewRecorder,
+		a.initReminderScheduler,
 		metric.Init,
 	}
 
@@ -184,7 +247,15 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 }
 
 func (a *App) initHTTPServer(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	// x-user-id/x-user-role проставляет gateway из проверенного токена, из клиентских заголовков их не берём
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.HasPrefix(strings.ToLower(key), strings.ToLower(runtime.MetadataHeaderPrefix+"x-user-")) {
+				return "", false
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+	)
 
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -194,7 +265,7 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 
 	err = reviewsDesc.RegisterReviewsV1HandlerFromEndpoint(ctx, mux, a.serviceProvider.GRPCConfig().Address(), opts)
 
-	handlerWithAuth := middleware.AuthMiddleware(mux)
+	handlerWithAuth := middleware.AuthMiddleware(middleware.IcsMiddleware(mux))
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// CORS заголовки
@@ -276,6 +347,41 @@ func (a *App) runKafkaConsumer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) runOutboxRelay(ctx context.Context) error {
+	log.Printf("Outbox relay is publishing to %s", a.serviceProvider.OutboxConfig().Topic())
+	return a.outboxRelay.Run(ctx)
+}
+
+func (a *App) initOutboxRelay(ctx context.Context) error {
+	a.outboxRelay = a.serviceProvider.OutboxRelay(ctx)
+	return nil
+}
+
+func (a *App) runPopularityRefresher(ctx context.Context) error {
+	log.Printf("Popularity refresher is running every %s", a.serviceProvider.PopularityConfig().RefreshInterval())
+	return a.popularityRefresher.Run(ctx)
+}
+
+func (a *App) initPopularityRefresher(ctx context.Context) error {
+	a.popularityRefresher = a.serviceProvider.PopularityRefresher(ctx)
+	return nil
+}
+
+func (a *App) initViewRecorder(ctx context.Context) error {
+	a.viewRecorder = a.serviceProvider.ViewRecorder(ctx)
+	return nil
+}
+
+func (a *App) runReminderScheduler(ctx context.Context) error {
+	log.Printf("Reminder scheduler is sending through %s notifier", a.serviceProvider.ReminderConfig().Notifier())
+	return a.reminderScheduler.Run(ctx)
+}
+
+func (a *App) initReminderScheduler(ctx context.Context) error {
+	a.reminderScheduler = a.serviceProvider.ReminderScheduler(ctx)
+	return nil
+}
+
 type handler struct {
 }
 
@@ -286,7 +392,21 @@ func (h *handler) Handle(msg []byte, topic kafka2.TopicPartition, consumerNumber
 func (a *App) initKafkaConsumer(ctx context.Context) error {
 	kafkaCfg := a.serviceProvider.KafkaConfig()
 	cn := 0
-	consumer, err := kafka.NewConsumer(kafkaCfg.Brokers(), kafkaCfg.Topics(), a.serviceProvider.EventsHandler(ctx), cn)
+	opts := kafka.Options{
+		Retry: kafka.RetryPolicy{
+			MaxAttempts:    kafkaCfg.RetryMaxAttempts(),
+			InitialBackoff: kafkaCfg.RetryBackoff(),
+			MaxBackoff:     kafkaCfg.RetryMaxBackoff(),
+		},
+		DeadLetters: kafka.NewDeadLetterPublisher(a.serviceProvider.KafkaProducer(), kafkaCfg.DLQTopic()),
+		Quarantine:  kafka.NewDeadLetterPublisher(a.serviceProvider.KafkaProducer(), kafkaCfg.QuarantineTopic()),
+
+		Workers:       kafkaCfg.Workers(),
+		QueueSize:     kafkaCfg.QueueSize(),
+		BatchSize:     kafkaCfg.BatchSize(),
+		FlushInterval: kafkaCfg.BatchFlushInterval(),
+	}
+	consumer, err := kafka.NewConsumer(kafkaCfg.Brokers(), kafkaCfg.Topics(), a.serviceProvider.EventsHandler(ctx), cn, opts)
 
 	if err != nil {
 		return err
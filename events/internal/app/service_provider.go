@@ -9,13 +9,24 @@ import (
 	grpcClients "github.com/M1steryO/RelocatorEvents/events/internal/client/grpc"
 	"github.com/M1steryO/RelocatorEvents/events/internal/client/grpc/auth"
 	"github.com/M1steryO/RelocatorEvents/events/internal/client/grpc/users"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/kafka"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/notifier"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/notifier/fake"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/notifier/telegram"
 	"github.com/M1steryO/RelocatorEvents/events/internal/config"
 	eventsConsumer "github.com/M1steryO/RelocatorEvents/events/internal/consumer/kafka/events"
 	"github.com/M1steryO/RelocatorEvents/events/internal/repository"
+	analyticsRepo "github.com/M1steryO/RelocatorEvents/events/internal/repository/analytics"
 	repo "github.com/M1steryO/RelocatorEvents/events/internal/repository/events"
+	outboxRepo "github.com/M1steryO/RelocatorEvents/events/internal/repository/outbox"
+	remindersRepo "github.com/M1steryO/RelocatorEvents/events/internal/repository/reminders"
 	reviewsRepo "github.com/M1steryO/RelocatorEvents/events/internal/repository/reviews"
 	"github.com/M1steryO/RelocatorEvents/events/internal/service"
+	analyticsServ "github.com/M1steryO/RelocatorEvents/events/internal/service/analytics"
 	serv "github.com/M1steryO/RelocatorEvents/events/internal/service/events"
+	outboxServ "github.com/M1steryO/RelocatorEvents/events/internal/service/outbox"
+	popularityServ "github.com/M1steryO/RelocatorEvents/events/internal/service/popularity"
+	remindersServ "github.com/M1steryO/RelocatorEvents/events/internal/service/reminders"
 	reviewsServ "github.com/M1steryO/RelocatorEvents/events/internal/service/reviews"
 	"github.com/M1steryO/platform_common/pkg/closer"
 	dbclient "github.com/M1steryO/platform_common/pkg/db"
@@ -36,18 +47,39 @@ type serviceProvider struct {
 	promConfig        config.PromConfig
 	kafkaConfig       config.KafkaConfig
 	authServiceConfig config.AuthServiceConfig
-
-	eventRepository  repository.EventRepository
-	reviewRepository repository.ReviewRepository
+	cursorConfig      config.CursorConfig
+	outboxConfig      config.OutboxConfig
+	popularityConfig  config.PopularityConfig
+	analyticsConfig   config.AnalyticsConfig
+	calendarConfig    config.CalendarConfig
+	reminderConfig    config.ReminderConfig
+	telegramConfig    config.TelegramConfig
+
+	eventRepository     repository.EventRepository
+	reviewRepository    repository.ReviewRepository
+	outboxRepository    repository.OutboxRepository
+	analyticsRepository repository.AnalyticsRepository
+	reminderRepository  repository.ReminderRepository
 
 	authServiceClient grpcClients.AuthServiceClient
 	userServiceClient grpcClients.UserServiceClient
 
+	notifier notifier.Notifier
+
+	kafkaProducer      *kafka.Producer
+	deadLetterReplayer kafka.DeadLetterReplayer
+
 	dbClient  dbclient.Client
 	txManager dbclient.TxManager
 
 	eventService  service.EventService
 	reviewService service.ReviewService
+	outboxRelay   service.OutboxRelay
+
+	popularityRefresher service.PopularityRefresher
+	viewRecorder        service.ViewRecorder
+	reminderService     service.ReminderService
+	reminderScheduler   service.ReminderScheduler
 
 	eventsImpl  *events.EventsImplementation
 	reviewsImpl *reviews.ReviewsImplementation
@@ -116,6 +148,83 @@ func (s *serviceProvider) KafkaConfig() config.KafkaConfig {
 	return s.kafkaConfig
 }
 
+func (s *serviceProvider) CursorConfig() config.CursorConfig {
+	if s.cursorConfig == nil {
+		cfg, err := config.NewCursorConfig()
+		if err != nil {
+			log.Fatalf("failed to get cursor config: %s", err.Error())
+		}
+		s.cursorConfig = cfg
+	}
+	return s.cursorConfig
+}
+
+func (s *serviceProvider) OutboxConfig() config.OutboxConfig {
+	if s.outboxConfig == nil {
+		cfg, err := config.NewOutboxConfig()
+		if err != nil {
+			log.Fatalf("failed to get outbox config: %s", err.Error())
+		}
+		s.outboxConfig = cfg
+	}
+	return s.outboxConfig
+}
+
+func (s *serviceProvider) PopularityConfig() config.PopularityConfig {
+	if s.popularityConfig == nil {
+		cfg, err := config.NewPopularityConfig()
+		if err != nil {
+			log.Fatalf("failed to get popularity config: %s", err.Error())
+		}
+		s.popularityConfig = cfg
+	}
+	return s.popularityConfig
+}
+
+func (s *serviceProvider) AnalyticsConfig() config.AnalyticsConfig {
+	if s.analyticsConfig == nil {
+		cfg, err := config.NewAnalyticsConfig()
+		if err != nil {
+			log.Fatalf("failed to get analytics config: %s", err.Error())
+		}
+		s.analyticsConfig = cfg
+	}
+	return s.analyticsConfig
+}
+
+func (s *serviceProvider) CalendarConfig() config.CalendarConfig {
+	if s.calendarConfig == nil {
+		cfg, err := config.NewCalendarConfig()
+		if err != nil {
+			log.Fatalf("failed to get calendar config: %s", err.Error())
+		}
+		s.calendarConfig = cfg
+	}
+	return s.calendarConfig
+}
+
+func (s *serviceProvider) ReminderConfig() config.ReminderConfig {
+	if s.reminderConfig == nil {
+		cfg, err := config.NewReminderConfig()
+		if err != nil {
+			log.Fatalf("failed to get reminder config: %s", err.Error())
+		}
+		s.reminderConfig = cfg
+	}
+	return s.reminderConfig
+}
+
+func (s *serviceProvider) TelegramConfig() config.TelegramConfig {
+	if s.telegramConfig == nil {
+		cfg, err := config.NewTelegramConfig()
+		if err != nil {
+			log.Fatalf("failed to get telegram config: %s", err.Error())
+		}
+		s.telegramConfig = cfg
+	}
+	return s.telegramConfig
+}
+
 func (s *serviceProvider) DBCClient(ctx context.Context) dbclient.Client {
 	if s.dbClient == nil {
 		cl, err := pg.New(ctx, s.DBConfig().GetDSN())
@@ -165,8 +274,14 @@ func (s *serviceProvider) EventService(ctx context.Context) service.EventService
 	if s.eventService == nil {
 		s.eventService = serv.NewEventService(
 			s.EventRepository(ctx),
+			s.OutboxRepository(ctx),
+			s.AnalyticsRepository(ctx),
 			s.TxManager(ctx),
 			s.UserServiceClient(),
+			s.ViewRecorder(ctx),
+			s.CursorConfig().Secret(),
+			s.DeadLetterReplayer(),
+			s.CalendarConfig().FeedBaseURL(),
 		)
 	}
 
@@ -175,7 +290,7 @@ func (s *serviceProvider) EventService(ctx context.Context) service.EventService
 
 func (s *serviceProvider) EventsImpl(ctx context.Context) *events.EventsImplementation {
 	if s.eventsImpl == nil {
-		s.eventsImpl = events.NewEventsImplementation(s.EventService(ctx))
+		s.eventsImpl = events.NewEventsImplementation(s.EventService(ctx), s.ReminderService(ctx))
 	}
 
 	return s.eventsImpl
@@ -194,13 +309,129 @@ func (s *serviceProvider) ReviewService(ctx context.Context) service.ReviewServi
 		s.reviewService = reviewsServ.NewReviewService(
 			s.ReviewRepository(ctx),
 			s.EventRepository(ctx),
+			s.OutboxRepository(ctx),
 			s.TxManager(ctx),
+			s.CursorConfig().Secret(),
 		)
 	}
 
 	return s.reviewService
 }
 
+func (s *serviceProvider) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if s.outboxRepository == nil {
+		s.outboxRepository = outboxRepo.NewOutboxRepository(s.DBCClient(ctx))
+	}
+
+	return s.outboxRepository
+}
+
+func (s *serviceProvider) OutboxRelay(ctx context.Context) service.OutboxRelay {
+	if s.outboxRelay == nil {
+		s.outboxRelay = outboxServ.NewOutboxRelay(
+			s.OutboxRepository(ctx),
+			s.TxManager(ctx),
+			s.KafkaProducer(),
+			s.OutboxConfig().Topic(),
+			s.OutboxConfig().BatchSize(),
+			s.OutboxConfig().PollInterval(),
+			s.OutboxConfig().MaxAttempts(),
+			s.OutboxConfig().Retention(),
+		)
+	}
+
+	return s.outboxRelay
+}
+
+func (s *serviceProvider) PopularityRefresher(ctx context.Context) service.PopularityRefresher {
+	if s.popularityRefresher == nil {
+		s.popularityRefresher = popularityServ.NewPopularityRefresher(
+			s.EventRepository(ctx),
+			s.TxManager(ctx),
+			s.PopularityConfig().RefreshInterval(),
+			s.PopularityConfig().HalfLife(),
+		)
+	}
+
+	return s.popularityRefresher
+}
+
+func (s *serviceProvider) AnalyticsRepository(ctx context.Context) repository.AnalyticsRepository {
+	if s.analyticsRepository == nil {
+		s.analyticsRepository = analyticsRepo.NewAnalyticsRepository(s.DBCClient(ctx))
+	}
+
+	return s.analyticsRepository
+}
+
+func (s *serviceProvider) ViewRecorder(ctx context.Context) service.ViewRecorder {
+	if s.viewRecorder == nil {
+		s.viewRecorder = analyticsServ.NewViewRecorder(
+			s.AnalyticsRepository(ctx),
+			s.TxManager(ctx),
+			s.AnalyticsConfig().BufferSize(),
+			s.AnalyticsConfig().BatchSize(),
+			s.AnalyticsConfig().FlushInterval(),
+			s.AnalyticsConfig().AggregateInterval(),
+		)
+	}
+
+	return s.viewRecorder
+}
+
+func (s *serviceProvider) ReminderRepository(ctx context.Context) repository.ReminderRepository {
+	if s.reminderRepository == nil {
+		s.reminderRepository = remindersRepo.NewReminderRepository(s.DBCClient(ctx))
+	}
+
+	return s.reminderRepository
+}
+
+func (s *serviceProvider) ReminderService(ctx context.Context) service.ReminderService {
+	if s.reminderService == nil {
+		s.reminderService = remindersServ.NewReminderService(
+			s.ReminderRepository(ctx),
+			s.ReminderConfig().DefaultHoursBefore(),
+		)
+	}
+
+	return s.reminderService
+}
+
+func (s *serviceProvider) ReminderScheduler(ctx context.Context) service.ReminderScheduler {
+	if s.reminderScheduler == nil {
+		cfg := s.ReminderConfig()
+		s.reminderScheduler = remindersServ.NewReminderScheduler(
+			s.ReminderRepository(ctx),
+			s.TxManager(ctx),
+			s.Notifier(),
+			s.UserServiceClient(),
+			remindersServ.SchedulerOptions{
+				PollInterval:       cfg.PollInterval(),
+				BatchSize:          cfg.BatchSize(),
+				DefaultHoursBefore: cfg.DefaultHoursBefore(),
+				MaxAttempts:        cfg.MaxAttempts(),
+				RetryBackoff:       cfg.RetryBackoff(),
+				SendingTimeout:     cfg.SendingTimeout(),
+			},
+		)
+	}
+
+	return s.reminderScheduler
+}
+
+func (s *serviceProvider) Notifier() notifier.Notifier {
+	if s.notifier == nil {
+		switch s.ReminderConfig().Notifier() {
+		case config.NotifierTelegram:
+			s.notifier = telegram.NewTelegramNotifier(s.TelegramConfig().Token(), s.UserServiceClient())
+		default:
+			s.notifier = fake.NewFakeNotifier()
+		}
+	}
+	return s.notifier
+}
+
 func (s *serviceProvider) ReviewsImpl(ctx context.Context) *reviews.ReviewsImplementation {
 	if s.reviewsImpl == nil {
 		s.reviewsImpl = reviews.NewReviewsImplementation(s.ReviewService(ctx))
@@ -216,6 +447,32 @@ func (s *serviceProvider) EventsHandler(ctx context.Context) *eventsConsumer.Eve
 	return s.eventsHandler
 }
 
+func (s *serviceProvider) KafkaProducer() *kafka.Producer {
+	if s.kafkaProducer == nil {
+		p, err := kafka.NewProducer(s.KafkaConfig().Brokers())
+		if err != nil {
+			log.Fatalf("failed to create kafka producer: %s", err.Error())
+		}
+		s.kafkaProducer = p
+		closer.Add(func() error {
+			p.Close()
+			return nil
+		})
+	}
+	return s.kafkaProducer
+}
+
+func (s *serviceProvider) DeadLetterReplayer() kafka.DeadLetterReplayer {
+	if s.deadLetterReplayer == nil {
+		s.deadLetterReplayer = kafka.NewDeadLetterReplayer(
+			s.KafkaConfig().Brokers(),
+			s.KafkaConfig().DLQTopic(),
+			s.KafkaProducer(),
+		)
+	}
+	return s.deadLetterReplayer
+}
+
 func (s *serviceProvider) AuthServiceConfig() config.AuthServiceConfig {
 	if s.authServiceConfig == nil {
 		cfg, err := config.NewAuthServiceConfig()
@@ -249,7 +506,9 @@ func (s *serviceProvider) UserServiceClient() grpcClients.UserServiceClient {
 		if err != nil {
 			log.Fatalf("failed to connect to auth service: %s", err.Error())
 		}
-		s.userServiceClient = users.NewUserServiceClient(user_v1.NewUserV1Client(conn))
+		s.userServiceClient = users.NewCachedUserServiceClient(
+			users.NewUserServiceClient(user_v1.NewUserV1Client(conn)),
+			s.AuthServiceConfig().ProfileCacheTTL())
 	}
 	return s.userServiceClient
 }
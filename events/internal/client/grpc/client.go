@@ -8,4 +8,19 @@ type AuthServiceClient interface {
 
 type UserServiceClient interface {
 	GetUserCountry(context.Context, int64) (string, error)
+	GetUserLocation(context.Context, int64) (*UserLocation, error)
+	GetUserProfile(context.Context, int64) (*UserProfile, error)
+}
+
+type UserLocation struct {
+	Country string
+	City    string
+}
+
+type UserProfile struct {
+	UserLocation
+	// Interests - коды категорий из профиля, совпадают с categories.code
+	Interests []string
+	// TelegramId - чат для уведомлений, nil - пользователь зарегистрирован не через telegram
+	TelegramId *int64
 }
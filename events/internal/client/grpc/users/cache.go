@@ -0,0 +1,76 @@
+package users
+
+import (
+	"context"
+	grpcClients "github.com/M1steryO/RelocatorEvents/events/internal/client/grpc"
+	"sync"
+	"time"
+)
+
+// maxCachedProfiles ограничивает память кэша: при переполнении он очищается целиком
+const maxCachedProfiles = 10000
+
+type cachedProfile struct {
+	profile   *grpcClients.UserProfile
+	expiresAt time.Time
+}
+
+// cachedUserServiceClient кэширует профили пользователей на ttl: список, карта и подсказки
+// запрашивают профиль на каждый запрос, а меняется он редко
+type cachedUserServiceClient struct {
+	client grpcClients.UserServiceClient
+	ttl    time.Duration
+
+	mu       sync.Mutex
+	profiles map[int64]cachedProfile
+}
+
+func NewCachedUserServiceClient(client grpcClients.UserServiceClient, ttl time.Duration) *cachedUserServiceClient {
+	return &cachedUserServiceClient{
+		client:   client,
+		ttl:      ttl,
+		profiles: make(map[int64]cachedProfile),
+	}
+}
+
+func (c *cachedUserServiceClient) GetUserProfile(ctx context.Context, userId int64) (*grpcClients.UserProfile, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	cached, ok := c.profiles[userId]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.profile, nil
+	}
+
+	profile, err := c.client.GetUserProfile(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if len(c.profiles) >= maxCachedProfiles {
+		c.profiles = make(map[int64]cachedProfile)
+	}
+	c.profiles[userId] = cachedProfile{profile: profile, expiresAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+
+	return profile, nil
+}
+
+func (c *cachedUserServiceClient) GetUserLocation(ctx context.Context, userId int64) (*grpcClients.UserLocation, error) {
+	profile, err := c.GetUserProfile(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	location := profile.UserLocation
+	return &location, nil
+}
+
+func (c *cachedUserServiceClient) GetUserCountry(ctx context.Context, userId int64) (string, error) {
+	profile, err := c.GetUserProfile(ctx, userId)
+	if err != nil {
+		return "", err
+	}
+	return profile.Country, nil
+}
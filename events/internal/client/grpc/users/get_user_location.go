@@ -0,0 +1,20 @@
+package users
+
+import (
+	"context"
+	desc "github.com/M1steryO/RelocatorEvents/auth/pkg/user_v1"
+	grpcClients "github.com/M1steryO/RelocatorEvents/events/internal/client/grpc"
+)
+
+func (c *userServiceClient) GetUserLocation(ctx context.Context, userId int64) (*grpcClients.UserLocation, error) {
+	req := &desc.GetRequest{Id: userId}
+
+	resp, err := c.client.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcClients.UserLocation{
+		Country: resp.User.Info.GetCountry(),
+		City:    resp.User.Info.GetCity(),
+	}, nil
+}
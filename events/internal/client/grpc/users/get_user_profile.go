@@ -0,0 +1,39 @@
+package users
+
+import (
+	"context"
+	desc "github.com/M1steryO/RelocatorEvents/auth/pkg/user_v1"
+	grpcClients "github.com/M1steryO/RelocatorEvents/events/internal/client/grpc"
+)
+
+func (c *userServiceClient) GetUserProfile(ctx context.Context, userId int64) (*grpcClients.UserProfile, error) {
+	req := &desc.GetRequest{Id: userId}
+
+	resp, err := c.client.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	info := resp.User.GetInfo()
+	interests := make([]string, 0, len(info.GetInterests()))
+	for _, interest := range info.GetInterests() {
+		if code := interest.GetCode(); code != "" {
+			interests = append(interests, code)
+		}
+	}
+
+	var telegramId *int64
+	if info.GetTelegramId() != nil {
+		id := info.GetTelegramId().GetValue()
+		telegramId = &id
+	}
+
+	return &grpcClients.UserProfile{
+		UserLocation: grpcClients.UserLocation{
+			Country: info.GetCountry(),
+			City:    info.GetCity(),
+		},
+		Interests:  interests,
+		TelegramId: telegramId,
+	}, nil
+}
@@ -2,29 +2,59 @@ package kafka
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/M1steryO/RelocatorEvents/events/internal/metric"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"log"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
-	consumerGroup  = "events-service"
-	sessionTimeout = 20000
-	noTimeout      = -1
+	consumerGroup   = "events-service"
+	sessionTimeout  = 20000
+	pollTimeout     = 100 * time.Millisecond // чтобы Start замечал остановку, не дожидаясь сообщений
+	shutdownTimeout = 30 * time.Second
 )
 
 type Handler interface {
 	Handle(ctx context.Context, msg []byte, topic kafka.TopicPartition, consumerNumber int) error
+	// HandleBatch обрабатывает пачку одной транзакцией. Ошибки по отдельным сообщениям возвращаются в срезе
+	// той же длины, ошибка батча целиком означает, что ничего не сохранено.
+	HandleBatch(ctx context.Context, msgs []*kafka.Message) ([]error, error)
+}
+
+type Options struct {
+	Retry       RetryPolicy
+	DeadLetters *DeadLetterPublisher
+	Quarantine  *DeadLetterPublisher
+
+	// Workers - число воркеров, партиция всегда попадает в один и тот же воркер
+	Workers int
+	// QueueSize - размер очереди воркера, ограничивает число сообщений в обработке
+	QueueSize     int
+	BatchSize     int
+	FlushInterval time.Duration
 }
 
 type Consumer struct {
 	consumer       *kafka.Consumer
 	handler        Handler
-	stop           bool
+	stop           atomic.Bool
+	done           chan struct{}
 	consumerNumber int
+
+	// failErr - почему консьюмер остановился сам, Start возвращает её после остановки воркеров
+	failOnce sync.Once
+	failErr  error
+
+	opts Options
 }
 
-func NewConsumer(address, topics []string, handler Handler, cn int) (*Consumer, error) {
+func NewConsumer(address, topics []string, handler Handler, cn int, opts Options) (*Consumer, error) {
 	configMap := kafka.ConfigMap{
 		"bootstrap.servers":        strings.Join(address, ","),
 		"group.id":                 consumerGroup,  // символичное имя для консьюмеров сервиса
@@ -44,37 +74,170 @@ func NewConsumer(address, topics []string, handler Handler, cn int) (*Consumer,
 	if err = c.SubscribeTopics(topics, nil); err != nil {
 		return nil, err
 	}
+
+	opts.Workers = max(opts.Workers, 1)
+	opts.QueueSize = max(opts.QueueSize, 1)
+	opts.BatchSize = max(opts.BatchSize, 1)
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = time.Second
+	}
+
 	return &Consumer{
 		consumer:       c,
 		handler:        handler,
+		done:           make(chan struct{}),
 		consumerNumber: cn,
+
+		opts: opts,
 	}, nil
 }
 
+// Start читает сообщения и раскладывает их по воркерам. Когда очереди воркеров заполнены,
+// чтение блокируется, так что в памяти одновременно не больше Workers*(QueueSize+BatchSize) сообщений.
 func (c *Consumer) Start(ctx context.Context) error {
-	for {
-		if c.stop {
-			break
-		}
-		msg, err := c.consumer.ReadMessage(noTimeout)
+	defer close(c.done)
+
+	queues := make([]chan *kafka.Message, c.opts.Workers)
+	wg := sync.WaitGroup{}
+	for i := range queues {
+		queues[i] = make(chan *kafka.Message, c.opts.QueueSize)
+		wg.Add(1)
+		go func(queue <-chan *kafka.Message) {
+			defer wg.Done()
+			c.runWorker(ctx, queue)
+		}(queues[i])
+	}
+
+	for !c.stop.Load() && ctx.Err() == nil {
+		msg, err := c.consumer.ReadMessage(pollTimeout)
 		if err != nil {
-			log.Printf("Error reading message from consumer: %v", err)
+			var kErr kafka.Error
+			if !errors.As(err, &kErr) || !kErr.IsTimeout() {
+				log.Printf("Error reading message from consumer: %v", err)
+			}
 		}
 		if msg == nil {
 			continue
 		}
-		if err = c.handler.Handle(ctx, msg.Value, msg.TopicPartition, c.consumerNumber); err != nil {
-			log.Printf("Error handling message: %v", err)
+
+		c.observeLag(msg.TopicPartition)
+		metric.AddKafkaInFlight(1)
+		queues[int(msg.TopicPartition.Partition)%len(queues)] <- msg
+	}
+
+	for _, queue := range queues {
+		close(queue)
+	}
+	wg.Wait()
+	return c.failErr
+}
+
+// fail останавливает чтение: оффсеты дальше не сохраняются, и после рестарта сообщения перечитаются
+func (c *Consumer) fail(err error) {
+	c.failOnce.Do(func() {
+		c.failErr = err
+		c.stop.Store(true)
+	})
+}
+
+func (c *Consumer) observeLag(tp kafka.TopicPartition) {
+	if tp.Topic == nil {
+		return
+	}
+	_, high, err := c.consumer.GetWatermarkOffsets(*tp.Topic, tp.Partition)
+	if err != nil || high <= 0 {
+		return
+	}
+	metric.SetKafkaConsumerLag(*tp.Topic, tp.Partition, max(high-int64(tp.Offset)-1, 0))
+}
+
+func (c *Consumer) storeMessage(msg *kafka.Message) {
+	metric.AddKafkaInFlight(-1)
+	if _, err := c.consumer.StoreMessage(msg); err != nil {
+		log.Printf("Error storing message: %v", err)
+	}
+}
+
+// process обрабатывает сообщение с ретраями, а если не вышло - отправляет его в DLQ.
+// Возвращает false, только если консьюмер остановили раньше, чем сообщение удалось сохранить.
+func (c *Consumer) process(ctx context.Context, msg *kafka.Message) bool {
+	attempts, err := c.handle(ctx, msg)
+	if err == nil {
+		metric.AddKafkaMessages(metric.KafkaResultProcessed, 1)
+		return true
+	}
+	if c.stop.Load() || ctx.Err() != nil {
+		return false // ретраи прервала остановка - сообщение перечитается после рестарта
+	}
+	return c.publishFailed(ctx, msg, err, attempts)
+}
+
+// publishFailed кладёт сообщение в DLQ, а невалидные сообщения (Permanent) - в карантин:
+// переигрывать их до исправления парсера бессмысленно.
+// Если DLQ недоступна дольше Retry.MaxAttempts попыток, консьюмер останавливается с ошибкой.
+func (c *Consumer) publishFailed(ctx context.Context, msg *kafka.Message, err error, attempts int) bool {
+	publisher, result := c.opts.DeadLetters, metric.KafkaResultDeadLetter
+	if IsPermanent(err) {
+		publisher, result = c.opts.Quarantine, metric.KafkaResultQuarantine
+	}
+
+	maxAttempts := max(c.opts.Retry.MaxAttempts, 1)
+	var pubErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		pubErr = publisher.Publish(msg, err, attempts)
+		if pubErr == nil {
+			log.Printf("Message %v sent to %s after %d attempts: %v", msg.TopicPartition, publisher.topic, attempts, err)
+			metric.AddKafkaMessages(result, 1)
+			return true
+		}
+		log.Printf("Error publishing message to %s (attempt %d/%d): %v", publisher.topic, attempt, maxAttempts, pubErr)
+		if attempt < maxAttempts && !c.wait(ctx, c.opts.Retry.Backoff(attempt)) {
+			return false
+		}
+	}
+
+	c.fail(fmt.Errorf("message %v was not published to %s: %w", msg.TopicPartition, publisher.topic, pubErr))
+	return false
+}
+
+func (c *Consumer) handle(ctx context.Context, msg *kafka.Message) (int, error) {
+	for attempt := 1; ; attempt++ {
+		err := c.handler.Handle(ctx, msg.Value, msg.TopicPartition, c.consumerNumber)
+		if err == nil {
+			return attempt, nil
+		}
+		if IsPermanent(err) || attempt >= c.opts.Retry.MaxAttempts {
+			return attempt, err
 		}
-		if _, err = c.consumer.StoreMessage(msg); err != nil {
-			log.Printf("Error storing message: %v", err)
+
+		delay := c.opts.Retry.Backoff(attempt)
+		log.Printf("Error handling message (attempt %d/%d), retry in %s: %v", attempt, c.opts.Retry.MaxAttempts, delay, err)
+		if !c.wait(ctx, delay) {
+			return attempt, err
 		}
 	}
-	return nil
+}
+
+func (c *Consumer) wait(ctx context.Context, d time.Duration) bool {
+	if c.stop.Load() {
+		return false
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return !c.stop.Load()
+	}
 }
 
 func (c *Consumer) Stop() error {
-	c.stop = true
+	c.stop.Store(true)
+	select {
+	case <-c.done: // воркеры дообработали прочитанное
+	case <-time.After(shutdownTimeout):
+		log.Printf("Kafka consumer did not stop in %s", shutdownTimeout)
+	}
+
 	if _, err := c.consumer.Commit(); err != nil {
 		return err // доотправка оффсетов прочитанных сообщений при остановке
 	}
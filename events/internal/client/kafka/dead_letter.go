@@ -0,0 +1,53 @@
+package kafka
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// DeadLetter - сообщение в DLQ: исходный payload и причина, по которой его не удалось обработать
+type DeadLetter struct {
+	Topic     string    `json:"topic"`
+	Partition int32     `json:"partition"`
+	Offset    int64     `json:"offset"`
+	Key       []byte    `json:"key,omitempty"`
+	Payload   []byte    `json:"payload"`
+	Error     string    `json:"error"`
+	Attempts  int       `json:"attempts"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+type DeadLetterPublisher struct {
+	producer *Producer
+	topic    string
+}
+
+func NewDeadLetterPublisher(producer *Producer, topic string) *DeadLetterPublisher {
+	return &DeadLetterPublisher{
+		producer: producer,
+		topic:    topic,
+	}
+}
+
+func (p *DeadLetterPublisher) Publish(msg *kafka.Message, handleErr error, attempts int) error {
+	dl := DeadLetter{
+		Partition: msg.TopicPartition.Partition,
+		Offset:    int64(msg.TopicPartition.Offset),
+		Key:       msg.Key,
+		Payload:   msg.Value,
+		Error:     handleErr.Error(),
+		Attempts:  attempts,
+		FailedAt:  time.Now().UTC(),
+	}
+	if msg.TopicPartition.Topic != nil {
+		dl.Topic = *msg.TopicPartition.Topic
+	}
+
+	data, err := json.Marshal(dl)
+	if err != nil {
+		return err
+	}
+	return p.producer.Produce(string(data), p.topic, string(msg.Key), dl.FailedAt)
+}
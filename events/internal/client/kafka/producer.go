@@ -15,6 +15,10 @@ const (
 
 var errUnknownType = errors.New("unknown event type")
 
+type MessageProducer interface {
+	Produce(message, topic, key string, tn time.Time) error
+}
+
 type Producer struct {
 	producer *kafka.Producer
 }
@@ -47,7 +51,8 @@ func (p *Producer) Produce(message, topic, key string, tn time.Time) error {
 	e := <-kafkaChan
 	switch ev := e.(type) {
 	case *kafka.Message:
-		return nil
+		// ошибка доставки приходит в самом сообщении
+		return ev.TopicPartition.Error
 	case kafka.Error:
 		return ev
 	default:
@@ -0,0 +1,149 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+const (
+	replayConsumerGroup = consumerGroup + "-dlq-replay"
+	replayPollTimeout   = 5 * time.Second
+	// replayJoinTimeout - сколько ждать, пока группа назначит партиции DLQ
+	replayJoinTimeout    = 30 * time.Second
+	replayQueryTimeoutMs = 5000
+)
+
+var ErrReplayNotAssigned = errors.New("dlq replay: no partitions assigned")
+
+type ReplayResult struct {
+	Replayed int
+	Skipped  int
+}
+
+type DeadLetterReplayer interface {
+	Replay(ctx context.Context, limit int) (*ReplayResult, error)
+}
+
+type deadLetterReplayer struct {
+	brokers  []string
+	topic    string
+	producer *Producer
+
+	mu sync.Mutex
+}
+
+func NewDeadLetterReplayer(brokers []string, topic string, producer *Producer) DeadLetterReplayer {
+	return &deadLetterReplayer{
+		brokers:  brokers,
+		topic:    topic,
+		producer: producer,
+	}
+}
+
+// Replay перекладывает до limit сообщений из DLQ обратно в исходные топики.
+// Оффсет коммитится только после успешной отправки, так что при ошибке сообщение останется в DLQ.
+func (r *deadLetterReplayer) Replay(ctx context.Context, limit int) (*ReplayResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  strings.Join(r.brokers, ","),
+		"group.id":           replayConsumerGroup,
+		"session.timeout.ms": sessionTimeout,
+		"auto.offset.reset":  "earliest",
+		"enable.auto.commit": false,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := c.Close(); err != nil {
+			log.Printf("Error closing dlq replay consumer: %v", err)
+		}
+	}()
+
+	if err = c.SubscribeTopics([]string{r.topic}, nil); err != nil {
+		return nil, err
+	}
+
+	result := &ReplayResult{}
+	joinDeadline := time.Now().Add(replayJoinTimeout)
+	for result.Replayed+result.Skipped < limit {
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+
+		msg, err := c.ReadMessage(replayPollTimeout)
+		if err != nil {
+			var kErr kafka.Error
+			if !errors.As(err, &kErr) || !kErr.IsTimeout() {
+				return result, err
+			}
+			// таймаут ещё не значит, что DLQ пуст: вход в группу может занять весь replayPollTimeout
+			done, err := drained(c)
+			if err != nil {
+				return result, err
+			}
+			if done {
+				break
+			}
+			if time.Now().After(joinDeadline) {
+				if parts, err := c.Assignment(); err != nil || len(parts) == 0 {
+					return result, ErrReplayNotAssigned
+				}
+			}
+			continue
+		}
+
+		var dl DeadLetter
+		if err = json.Unmarshal(msg.Value, &dl); err != nil || dl.Topic == "" {
+			log.Printf("Skipping malformed dead letter at offset %v: %v", msg.TopicPartition.Offset, err)
+			result.Skipped++
+		} else {
+			if err = r.producer.Produce(string(dl.Payload), dl.Topic, string(dl.Key), time.Now()); err != nil {
+				return result, err
+			}
+			result.Replayed++
+		}
+
+		if _, err = c.CommitMessage(msg); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// drained - консьюмеру назначены партиции DLQ и по каждой закоммичено всё до high watermark
+func drained(c *kafka.Consumer) (bool, error) {
+	parts, err := c.Assignment()
+	if err != nil || len(parts) == 0 {
+		return false, err
+	}
+
+	committed, err := c.Committed(parts, replayQueryTimeoutMs)
+	if err != nil {
+		return false, err
+	}
+	for _, tp := range committed {
+		low, high, err := c.QueryWatermarkOffsets(*tp.Topic, tp.Partition, replayQueryTimeoutMs)
+		if err != nil {
+			return false, err
+		}
+		// без коммита группа читает с начала партиции
+		offset := int64(tp.Offset)
+		if tp.Offset < 0 {
+			offset = low
+		}
+		if offset < high {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,41 @@
+package kafka
+
+import (
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// ErrPermanent помечает ошибки, которые бессмысленно повторять (битое или невалидное сообщение)
+var ErrPermanent = errors.New("permanent error")
+
+// Permanent оборачивает ошибку обработчика, чтобы сообщение без ретраев ушло в карантин
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errors.Join(ErrPermanent, err)
+}
+
+func IsPermanent(err error) bool {
+	return errors.Is(err, ErrPermanent)
+}
+
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff - экспоненциальная задержка перед следующей попыткой с джиттером ±20%
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	jitter := time.Duration(float64(delay) * (rand.Float64()*0.4 - 0.2))
+	return delay + jitter
+}
@@ -0,0 +1,88 @@
+package kafka
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/metric"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"log"
+	"time"
+)
+
+// runWorker копит сообщения в батч и обрабатывает его, когда батч заполнен или прошёл FlushInterval.
+// Оффсеты сохраняются только после коммита батча. Если сообщение не удалось ни сохранить, ни отправить в DLQ,
+// оффсеты дальше не двигаются, а консьюмер останавливается (см. publishFailed):
+// сообщения этих партиций перечитаются после рестарта.
+func (c *Consumer) runWorker(ctx context.Context, queue <-chan *kafka.Message) {
+	batch := make([]*kafka.Message, 0, c.opts.BatchSize)
+	ticker := time.NewTicker(c.opts.FlushInterval)
+	defer ticker.Stop()
+
+	healthy := true
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if healthy {
+			healthy = c.processBatch(ctx, batch)
+		} else {
+			metric.AddKafkaInFlight(-len(batch))
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case msg, ok := <-queue:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, msg)
+			if len(batch) >= c.opts.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (c *Consumer) processBatch(ctx context.Context, batch []*kafka.Message) bool {
+	start := time.Now()
+	errs, err := c.handler.HandleBatch(ctx, batch)
+	if err != nil {
+		log.Printf("Error handling batch of %d messages, processing one by one: %v", len(batch), err)
+		return c.processEach(ctx, batch)
+	}
+	metric.ObserveKafkaBatch(len(batch), time.Since(start).Seconds())
+
+	for i, msg := range batch {
+		if i < len(errs) && errs[i] != nil {
+			var ok bool
+			if IsPermanent(errs[i]) {
+				ok = c.publishFailed(ctx, msg, errs[i], 1)
+			} else {
+				ok = c.process(ctx, msg)
+			}
+			if !ok {
+				metric.AddKafkaInFlight(-(len(batch) - i))
+				return false
+			}
+		} else {
+			metric.AddKafkaMessages(metric.KafkaResultProcessed, 1)
+		}
+		c.storeMessage(msg)
+	}
+	return true
+}
+
+func (c *Consumer) processEach(ctx context.Context, batch []*kafka.Message) bool {
+	for i, msg := range batch {
+		if !c.process(ctx, msg) {
+			metric.AddKafkaInFlight(-(len(batch) - i))
+			return false
+		}
+		c.storeMessage(msg)
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package fake
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/notifier"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	"log/slog"
+	"sync"
+)
+
+// fakeNotifier ничего не отправляет: пишет уведомление в лог и запоминает его. Для локального запуска и тестов
+type fakeNotifier struct {
+	mu   sync.Mutex
+	sent []*notifier.Notification
+}
+
+func NewFakeNotifier() *fakeNotifier {
+	return &fakeNotifier{}
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, notification *notifier.Notification) error {
+	logger.Info("notification",
+		slog.Int64("user_id", notification.UserId),
+		slog.String("text", notification.Text),
+	)
+
+	n.mu.Lock()
+	n.sent = append(n.sent, notification)
+	n.mu.Unlock()
+	return nil
+}
+
+// Sent возвращает копию отправленных уведомлений
+func (n *fakeNotifier) Sent() []*notifier.Notification {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	sent := make([]*notifier.Notification, len(n.sent))
+	copy(sent, n.sent)
+	return sent
+}
@@ -0,0 +1,19 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrRecipientUnavailable - доставка невозможна и повтор не поможет:
+// у пользователя нет чата или он заблокировал бота
+var ErrRecipientUnavailable = errors.New("notification recipient is unavailable")
+
+type Notification struct {
+	UserId int64
+	Text   string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
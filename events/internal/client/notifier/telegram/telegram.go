@@ -0,0 +1,104 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	grpcClients "github.com/M1steryO/RelocatorEvents/events/internal/client/grpc"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/notifier"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	apiURL         = "https://api.telegram.org"
+	requestTimeout = 10 * time.Second
+)
+
+// telegramNotifier отправляет уведомления сообщением от бота через Bot API.
+// Пользователи регистрируются через telegram, поэтому chat_id личного чата совпадает с telegram_id профиля
+type telegramNotifier struct {
+	token      string
+	userClient grpcClients.UserServiceClient
+	httpClient *http.Client
+}
+
+func NewTelegramNotifier(token string, userClient grpcClients.UserServiceClient) *telegramNotifier {
+	return &telegramNotifier{
+		token:      token,
+		userClient: userClient,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+type sendMessageRequest struct {
+	ChatId                int64  `json:"chat_id"`
+	Text                  string `json:"text"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+type apiResponse struct {
+	Ok          bool   `json:"ok"`
+	ErrorCode   int    `json:"error_code"`
+	Description string `json:"description"`
+}
+
+func (n *telegramNotifier) Notify(ctx context.Context, notification *notifier.Notification) error {
+	profile, err := n.userClient.GetUserProfile(ctx, notification.UserId)
+	if err != nil {
+		return err
+	}
+	if profile.TelegramId == nil {
+		return notifier.ErrRecipientUnavailable
+	}
+
+	body, err := json.Marshal(&sendMessageRequest{
+		ChatId:                *profile.TelegramId,
+		Text:                  notification.Text,
+		DisableWebPagePreview: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/bot%s/sendMessage", apiURL, n.token), bytes.NewReader(body))
+	if err != nil {
+		return withoutURL(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return withoutURL(err)
+	}
+	defer resp.Body.Close()
+
+	var res apiResponse
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return fmt.Errorf("telegram: unexpected response %d: %w", resp.StatusCode, err)
+	}
+	if res.Ok {
+		return nil
+	}
+
+	switch res.ErrorCode {
+	// 403 - бот заблокирован пользователем, 400 - чат не найден: повторять бессмысленно
+	case http.StatusForbidden, http.StatusBadRequest:
+		return fmt.Errorf("telegram: %s: %w", res.Description, notifier.ErrRecipientUnavailable)
+	default:
+		return fmt.Errorf("telegram: %d %s", res.ErrorCode, res.Description)
+	}
+}
+
+// withoutURL убирает из ошибки запроса URL с токеном бота: ошибка попадает в логи и reminders.last_error
+func withoutURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Errorf("telegram: %s sendMessage: %w", urlErr.Op, urlErr.Err)
+	}
+	return err
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	analyticsBufferSizeEnvName        = "ANALYTICS_BUFFER_SIZE"           // optional
+	analyticsBatchSizeEnvName         = "ANALYTICS_BATCH_SIZE"            // optional
+	analyticsFlushIntervalEnvName     = "ANALYTICS_FLUSH_INTERVAL_MS"     // optional
+	analyticsAggregateIntervalEnvName = "ANALYTICS_AGGREGATE_INTERVAL_MS" // optional
+)
+
+const (
+	defaultAnalyticsBufferSize        = 10000
+	defaultAnalyticsBatchSize         = 500
+	defaultAnalyticsFlushInterval     = 2 * time.Second
+	defaultAnalyticsAggregateInterval = 5 * time.Minute
+)
+
+type AnalyticsConfig interface {
+	BufferSize() int
+	BatchSize() int
+	FlushInterval() time.Duration
+	AggregateInterval() time.Duration
+}
+
+type analyticsConfig struct {
+	bufferSize        int
+	batchSize         int
+	flushInterval     time.Duration
+	aggregateInterval time.Duration
+}
+
+func NewAnalyticsConfig() (AnalyticsConfig, error) {
+	return &analyticsConfig{
+		bufferSize:        parseIntOrDefault(os.Getenv(analyticsBufferSizeEnvName), defaultAnalyticsBufferSize),
+		batchSize:         parseIntOrDefault(os.Getenv(analyticsBatchSizeEnvName), defaultAnalyticsBatchSize),
+		flushInterval:     parseMillisOrDefault(os.Getenv(analyticsFlushIntervalEnvName), defaultAnalyticsFlushInterval),
+		aggregateInterval: parseMillisOrDefault(os.Getenv(analyticsAggregateIntervalEnvName), defaultAnalyticsAggregateInterval),
+	}, nil
+}
+
+func (c *analyticsConfig) BufferSize() int                  { return c.bufferSize }
+func (c *analyticsConfig) BatchSize() int                   { return c.batchSize }
+func (c *analyticsConfig) FlushInterval() time.Duration     { return c.flushInterval }
+func (c *analyticsConfig) AggregateInterval() time.Duration { return c.aggregateInterval }
@@ -4,20 +4,26 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 )
 
 const (
 	authServiceGRPCHostEnvName = "AUTH_SERVICE_GRPC_HOST"
 	authServiceGRPCPortEnvName = "AUTH_SERVICE_GRPC_PORT"
+	userProfileCacheTTLEnvName = "USER_PROFILE_CACHE_TTL_MS" // optional
 )
 
+const defaultUserProfileCacheTTL = time.Minute
+
 type AuthServiceConfig interface {
 	GetAddress() string
+	ProfileCacheTTL() time.Duration
 }
 
 type authServiceConfig struct {
-	host string
-	port string
+	host            string
+	port            string
+	profileCacheTTL time.Duration
 }
 
 func NewAuthServiceConfig() (AuthServiceConfig, error) {
@@ -32,11 +38,16 @@ func NewAuthServiceConfig() (AuthServiceConfig, error) {
 	}
 
 	return &authServiceConfig{
-		host: host,
-		port: port,
+		host:            host,
+		port:            port,
+		profileCacheTTL: parseMillisOrDefault(os.Getenv(userProfileCacheTTLEnvName), defaultUserProfileCacheTTL),
 	}, nil
 }
 
 func (c *authServiceConfig) GetAddress() string {
 	return fmt.Sprintf("%s:%s", c.host, c.port)
 }
+
+func (c *authServiceConfig) ProfileCacheTTL() time.Duration {
+	return c.profileCacheTTL
+}
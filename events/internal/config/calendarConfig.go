@@ -0,0 +1,34 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+const (
+	calendarFeedBaseURLEnvName = "CALENDAR_FEED_BASE_URL" // optional
+)
+
+// defaultCalendarFeedBaseURL - лента отдаётся через gateway, без авторизации
+const defaultCalendarFeedBaseURL = "http://localhost:8080/v1/events/calendar"
+
+type CalendarConfig interface {
+	FeedBaseURL() string
+}
+
+type calendarConfig struct {
+	feedBaseURL string
+}
+
+func NewCalendarConfig() (CalendarConfig, error) {
+	baseURL := strings.TrimRight(strings.TrimSpace(os.Getenv(calendarFeedBaseURLEnvName)), "/")
+	if baseURL == "" {
+		baseURL = defaultCalendarFeedBaseURL
+	}
+
+	return &calendarConfig{
+		feedBaseURL: baseURL,
+	}, nil
+}
+
+func (c *calendarConfig) FeedBaseURL() string { return c.feedBaseURL }
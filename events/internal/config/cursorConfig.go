@@ -0,0 +1,33 @@
+package config
+
+import (
+	"errors"
+	"os"
+)
+
+const (
+	cursorSecretEnvName = "CURSOR_SECRET"
+)
+
+type CursorConfig interface {
+	Secret() []byte
+}
+
+type cursorConfig struct {
+	secret []byte
+}
+
+func NewCursorConfig() (CursorConfig, error) {
+	secret := os.Getenv(cursorSecretEnvName)
+	if len(secret) == 0 {
+		return nil, errors.New("cursor secret not found")
+	}
+
+	return &cursorConfig{
+		secret: []byte(secret),
+	}, nil
+}
+
+func (c *cursorConfig) Secret() []byte {
+	return c.secret
+}
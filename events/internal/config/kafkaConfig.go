@@ -20,10 +20,31 @@ const (
 	kafkaTLSEnvName      = "KAFKA_TLS"       // optional: "true"/"false"
 
 	kafkaDialTimeoutEnvName = "KAFKA_DIAL_TIMEOUT_MS" // optional
+
+	kafkaDLQTopicEnvName         = "KAFKA_DLQ_TOPIC"            // optional: "events.new.dlq"
+	kafkaQuarantineTopicEnvName  = "KAFKA_QUARANTINE_TOPIC"     // optional: "events.new.quarantine"
+	kafkaRetryMaxAttemptsEnvName = "KAFKA_RETRY_MAX_ATTEMPTS"   // optional
+	kafkaRetryBackoffEnvName     = "KAFKA_RETRY_BACKOFF_MS"     // optional
+	kafkaWorkersEnvName          = "KAFKA_WORKERS"              // optional
+	kafkaQueueSizeEnvName        = "KAFKA_QUEUE_SIZE"           // optional: размер очереди одного воркера
+	kafkaBatchSizeEnvName        = "KAFKA_BATCH_SIZE"           // optional
+	kafkaBatchFlushEnvName       = "KAFKA_BATCH_FLUSH_MS"       // optional
+	kafkaRetryMaxBackoffEnvName  = "KAFKA_RETRY_MAX_BACKOFF_MS" // optional
 )
 
 const (
 	defaultKafkaDialTimeout = 5 * time.Second
+
+	defaultKafkaDLQTopic         = "events.new.dlq"
+	defaultKafkaQuarantineTopic  = "events.new.quarantine"
+	defaultKafkaRetryMaxAttempts = 5
+	defaultKafkaRetryBackoff     = 200 * time.Millisecond
+	defaultKafkaWorkers          = 4
+	defaultKafkaQueueSize        = 200
+	defaultKafkaBatchSize        = 100
+	maxKafkaBatchSize            = 1000
+	defaultKafkaBatchFlush       = 500 * time.Millisecond
+	defaultKafkaRetryMaxBackoff  = 10 * time.Second
 )
 
 type KafkaConfig interface {
@@ -38,6 +59,17 @@ type KafkaConfig interface {
 	TLS() bool
 
 	DialTimeout() time.Duration
+
+	DLQTopic() string
+	QuarantineTopic() string
+	RetryMaxAttempts() int
+	RetryBackoff() time.Duration
+	RetryMaxBackoff() time.Duration
+
+	Workers() int
+	QueueSize() int
+	BatchSize() int
+	BatchFlushInterval() time.Duration
 }
 
 type kafkaConfig struct {
@@ -52,6 +84,17 @@ type kafkaConfig struct {
 	tls           bool
 
 	dialTimeout time.Duration
+
+	dlqTopic         string
+	quarantineTopic  string
+	retryMaxAttempts int
+	retryBackoff     time.Duration
+	retryMaxBackoff  time.Duration
+
+	workers            int
+	queueSize          int
+	batchSize          int
+	batchFlushInterval time.Duration
 }
 
 func NewKafkaConfig() (KafkaConfig, error) {
@@ -92,6 +135,29 @@ func NewKafkaConfig() (KafkaConfig, error) {
 		}
 	}
 
+	dlqTopic := strings.TrimSpace(os.Getenv(kafkaDLQTopicEnvName))
+	if dlqTopic == "" {
+		dlqTopic = defaultKafkaDLQTopic
+	}
+
+	quarantineTopic := strings.TrimSpace(os.Getenv(kafkaQuarantineTopicEnvName))
+	if quarantineTopic == "" {
+		quarantineTopic = defaultKafkaQuarantineTopic
+	}
+
+	retryMaxAttempts := parseIntOrDefault(os.Getenv(kafkaRetryMaxAttemptsEnvName), defaultKafkaRetryMaxAttempts)
+
+	retryBackoff := parseMillisOrDefault(os.Getenv(kafkaRetryBackoffEnvName), defaultKafkaRetryBackoff)
+	retryMaxBackoff := parseMillisOrDefault(os.Getenv(kafkaRetryMaxBackoffEnvName), defaultKafkaRetryMaxBackoff)
+	if retryMaxBackoff < retryBackoff {
+		retryMaxBackoff = retryBackoff
+	}
+
+	workers := parseIntOrDefault(os.Getenv(kafkaWorkersEnvName), defaultKafkaWorkers)
+	queueSize := parseIntOrDefault(os.Getenv(kafkaQueueSizeEnvName), defaultKafkaQueueSize)
+	batchSize := min(parseIntOrDefault(os.Getenv(kafkaBatchSizeEnvName), defaultKafkaBatchSize), maxKafkaBatchSize)
+	batchFlushInterval := parseMillisOrDefault(os.Getenv(kafkaBatchFlushEnvName), defaultKafkaBatchFlush)
+
 	return &kafkaConfig{
 		brokers: brokers,
 		topics:  topics,
@@ -104,18 +170,38 @@ func NewKafkaConfig() (KafkaConfig, error) {
 		tls:           tls,
 
 		dialTimeout: dialTimeout,
+
+		dlqTopic:         dlqTopic,
+		quarantineTopic:  quarantineTopic,
+		retryMaxAttempts: retryMaxAttempts,
+		retryBackoff:     retryBackoff,
+		retryMaxBackoff:  retryMaxBackoff,
+
+		workers:            workers,
+		queueSize:          queueSize,
+		batchSize:          batchSize,
+		batchFlushInterval: batchFlushInterval,
 	}, nil
 }
 
-func (c *kafkaConfig) Brokers() []string          { return c.brokers }
-func (c *kafkaConfig) Topics() []string           { return c.topics }
-func (c *kafkaConfig) GroupID() string            { return c.groupID }
-func (c *kafkaConfig) ClientID() string           { return c.clientID }
-func (c *kafkaConfig) Username() string           { return c.username }
-func (c *kafkaConfig) Password() string           { return c.password }
-func (c *kafkaConfig) SASLMechanism() string      { return c.saslMechanism }
-func (c *kafkaConfig) TLS() bool                  { return c.tls }
-func (c *kafkaConfig) DialTimeout() time.Duration { return c.dialTimeout }
+func (c *kafkaConfig) Brokers() []string                 { return c.brokers }
+func (c *kafkaConfig) Topics() []string                  { return c.topics }
+func (c *kafkaConfig) GroupID() string                   { return c.groupID }
+func (c *kafkaConfig) ClientID() string                  { return c.clientID }
+func (c *kafkaConfig) Username() string                  { return c.username }
+func (c *kafkaConfig) Password() string                  { return c.password }
+func (c *kafkaConfig) SASLMechanism() string             { return c.saslMechanism }
+func (c *kafkaConfig) TLS() bool                         { return c.tls }
+func (c *kafkaConfig) DialTimeout() time.Duration        { return c.dialTimeout }
+func (c *kafkaConfig) DLQTopic() string                  { return c.dlqTopic }
+func (c *kafkaConfig) QuarantineTopic() string           { return c.quarantineTopic }
+func (c *kafkaConfig) RetryMaxAttempts() int             { return c.retryMaxAttempts }
+func (c *kafkaConfig) RetryBackoff() time.Duration       { return c.retryBackoff }
+func (c *kafkaConfig) RetryMaxBackoff() time.Duration    { return c.retryMaxBackoff }
+func (c *kafkaConfig) Workers() int                      { return c.workers }
+func (c *kafkaConfig) QueueSize() int                    { return c.queueSize }
+func (c *kafkaConfig) BatchSize() int                    { return c.batchSize }
+func (c *kafkaConfig) BatchFlushInterval() time.Duration { return c.batchFlushInterval }
 
 // helpers
 
@@ -141,3 +227,27 @@ func parseBoolOrDefault(s string, def bool) bool {
 	}
 	return v
 }
+
+func parseIntOrDefault(s string, def int) int {
+	v := strings.TrimSpace(s)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return def
+	}
+	return n
+}
+
+func parseMillisOrDefault(s string, def time.Duration) time.Duration {
+	v := strings.TrimSpace(s)
+	if v == "" {
+		return def
+	}
+	ms, err := strconv.Atoi(v)
+	if err != nil || ms <= 0 {
+		return def
+	}
+	return time.Duration(ms) * time.Millisecond
+}
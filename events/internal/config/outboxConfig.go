@@ -0,0 +1,62 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	outboxTopicEnvName        = "OUTBOX_TOPIC"            // optional: "events.outbox"
+	outboxPollIntervalEnvName = "OUTBOX_POLL_INTERVAL_MS" // optional
+	outboxBatchSizeEnvName    = "OUTBOX_BATCH_SIZE"       // optional
+	outboxMaxAttemptsEnvName  = "OUTBOX_MAX_ATTEMPTS"     // optional
+	outboxRetentionEnvName    = "OUTBOX_RETENTION_MS"     // optional
+)
+
+const (
+	defaultOutboxTopic        = "events.outbox"
+	defaultOutboxPollInterval = time.Second
+	defaultOutboxBatchSize    = 100
+	defaultOutboxMaxAttempts  = 10
+	defaultOutboxRetention    = 7 * 24 * time.Hour
+)
+
+type OutboxConfig interface {
+	Topic() string
+	PollInterval() time.Duration
+	BatchSize() int
+	// MaxAttempts - после стольких неудачных отправок сообщение помечается failed и пропускается
+	MaxAttempts() int
+	// Retention - сколько хранить отправленные сообщения
+	Retention() time.Duration
+}
+
+type outboxConfig struct {
+	topic        string
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	retention    time.Duration
+}
+
+func NewOutboxConfig() (OutboxConfig, error) {
+	topic := strings.TrimSpace(os.Getenv(outboxTopicEnvName))
+	if topic == "" {
+		topic = defaultOutboxTopic
+	}
+
+	return &outboxConfig{
+		topic:        topic,
+		pollInterval: parseMillisOrDefault(os.Getenv(outboxPollIntervalEnvName), defaultOutboxPollInterval),
+		batchSize:    parseIntOrDefault(os.Getenv(outboxBatchSizeEnvName), defaultOutboxBatchSize),
+		maxAttempts:  parseIntOrDefault(os.Getenv(outboxMaxAttemptsEnvName), defaultOutboxMaxAttempts),
+		retention:    parseMillisOrDefault(os.Getenv(outboxRetentionEnvName), defaultOutboxRetention),
+	}, nil
+}
+
+func (c *outboxConfig) Topic() string               { return c.topic }
+func (c *outboxConfig) PollInterval() time.Duration { return c.pollInterval }
+func (c *outboxConfig) BatchSize() int              { return c.batchSize }
+func (c *outboxConfig) MaxAttempts() int            { return c.maxAttempts }
+func (c *outboxConfig) Retention() time.Duration    { return c.retention }
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	popularityRefreshIntervalEnvName = "POPULARITY_REFRESH_INTERVAL_MS" // optional
+	popularityHalfLifeEnvName        = "POPULARITY_HALF_LIFE_MS"        // optional
+)
+
+const (
+	defaultPopularityRefreshInterval = 10 * time.Minute
+	defaultPopularityHalfLife        = 72 * time.Hour
+)
+
+type PopularityConfig interface {
+	RefreshInterval() time.Duration
+	HalfLife() time.Duration
+}
+
+type popularityConfig struct {
+	refreshInterval time.Duration
+	halfLife        time.Duration
+}
+
+func NewPopularityConfig() (PopularityConfig, error) {
+	return &popularityConfig{
+		refreshInterval: parseMillisOrDefault(os.Getenv(popularityRefreshIntervalEnvName), defaultPopularityRefreshInterval),
+		halfLife:        parseMillisOrDefault(os.Getenv(popularityHalfLifeEnvName), defaultPopularityHalfLife),
+	}, nil
+}
+
+func (c *popularityConfig) RefreshInterval() time.Duration { return c.refreshInterval }
+func (c *popularityConfig) HalfLife() time.Duration        { return c.halfLife }
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	reminderNotifierEnvName           = "REMINDER_NOTIFIER"             // optional: telegram | fake
+	reminderPollIntervalEnvName       = "REMINDER_POLL_INTERVAL_MS"     // optional
+	reminderBatchSizeEnvName          = "REMINDER_BATCH_SIZE"           // optional
+	reminderDefaultHoursBeforeEnvName = "REMINDER_DEFAULT_HOURS_BEFORE" // optional
+	reminderMaxAttemptsEnvName        = "REMINDER_MAX_ATTEMPTS"         // optional
+	reminderRetryBackoffEnvName       = "REMINDER_RETRY_BACKOFF_MS"     // optional
+	reminderSendingTimeoutEnvName     = "REMINDER_SENDING_TIMEOUT_MS"   // optional
+)
+
+const (
+	NotifierTelegram = "telegram"
+	NotifierFake     = "fake"
+)
+
+const (
+	defaultReminderPollInterval       = time.Minute
+	defaultReminderBatchSize          = 100
+	defaultReminderDefaultHoursBefore = 24
+	defaultReminderMaxAttempts        = 3
+	defaultReminderRetryBackoff       = 5 * time.Minute
+	defaultReminderSendingTimeout     = 10 * time.Minute
+)
+
+type ReminderConfig interface {
+	Notifier() string
+	PollInterval() time.Duration
+	BatchSize() int
+	DefaultHoursBefore() int32
+	MaxAttempts() int32
+	RetryBackoff() time.Duration
+	SendingTimeout() time.Duration
+}
+
+type reminderConfig struct {
+	notifier           string
+	pollInterval       time.Duration
+	batchSize          int
+	defaultHoursBefore int32
+	maxAttempts        int32
+	retryBackoff       time.Duration
+	sendingTimeout     time.Duration
+}
+
+func NewReminderConfig() (ReminderConfig, error) {
+	notifier := strings.ToLower(strings.TrimSpace(os.Getenv(reminderNotifierEnvName)))
+	switch notifier {
+	case "":
+		notifier = NotifierFake
+	case NotifierTelegram, NotifierFake:
+	default:
+		return nil, fmt.Errorf("unknown %s: %s", reminderNotifierEnvName, notifier)
+	}
+
+	hoursBefore := parseIntOrDefault(os.Getenv(reminderDefaultHoursBeforeEnvName), defaultReminderDefaultHoursBefore)
+	if hoursBefore < 1 || hoursBefore > 168 {
+		return nil, fmt.Errorf("%s must be between 1 and 168", reminderDefaultHoursBeforeEnvName)
+	}
+
+	return &reminderConfig{
+		notifier:           notifier,
+		pollInterval:       parseMillisOrDefault(os.Getenv(reminderPollIntervalEnvName), defaultReminderPollInterval),
+		batchSize:          parseIntOrDefault(os.Getenv(reminderBatchSizeEnvName), defaultReminderBatchSize),
+		defaultHoursBefore: int32(hoursBefore),
+		maxAttempts:        int32(parseIntOrDefault(os.Getenv(reminderMaxAttemptsEnvName), defaultReminderMaxAttempts)),
+		retryBackoff:       parseMillisOrDefault(os.Getenv(reminderRetryBackoffEnvName), defaultReminderRetryBackoff),
+		sendingTimeout:     parseMillisOrDefault(os.Getenv(reminderSendingTimeoutEnvName), defaultReminderSendingTimeout),
+	}, nil
+}
+
+func (c *reminderConfig) Notifier() string              { return c.notifier }
+func (c *reminderConfig) PollInterval() time.Duration   { return c.pollInterval }
+func (c *reminderConfig) BatchSize() int                { return c.batchSize }
+func (c *reminderConfig) DefaultHoursBefore() int32     { return c.defaultHoursBefore }
+func (c *reminderConfig) MaxAttempts() int32            { return c.maxAttempts }
+func (c *reminderConfig) RetryBackoff() time.Duration   { return c.retryBackoff }
+func (c *reminderConfig) SendingTimeout() time.Duration { return c.sendingTimeout }
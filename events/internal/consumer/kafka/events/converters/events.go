@@ -7,18 +7,10 @@ import (
 	"time"
 )
 
+// ToDomainEvent ожидает сообщение, прошедшее Envelope.Validate
 func ToDomainEvent(src models.Event) *domain.Event {
 	now := time.Now()
 
-	var price int32
-	var pricePtr *int32
-
-	if src.Price != nil {
-		price = int32(*src.Price)
-	}
-
-	pricePtr = &price
-
 	ev := &domain.Event{
 		Title:       strings.TrimSpace(src.Title),
 		Description: strPtrOrNil(src.Description),
@@ -26,10 +18,10 @@ func ToDomainEvent(src models.Event) *domain.Event {
 
 		MinAge:   int32PtrFromIntPtr(src.Age),
 		Type:     domain.EventTypeOffline,
-		MinPrice: pricePtr,
-		Currency: strPtrOrNil(src.Currency),
+		MinPrice: minorPtrFromFloatPtr(src.Price),
+		MaxPrice: minorPtrFromFloatPtr(src.PriceMax),
+		Currency: currencyPtrOrNil(src.Currency),
 
-		StartsAt: src.StartsAt,
 		ImageUrl: strPtrOrNil(src.ImgURL),
 
 		Address: &domain.EventAddress{
@@ -39,18 +31,59 @@ func ToDomainEvent(src models.Event) *domain.Event {
 			Country: strings.TrimSpace(src.Country),
 			City:    strings.TrimSpace(src.City),
 
-			Latitude:  float64Ptr(src.Latitude),
-			Longitude: float64Ptr(src.Longitude),
+			Latitude:  src.Latitude,
+			Longitude: src.Longitude,
 
 			CreatedAt: now,
 		},
 
 		CreatedAt: now,
 	}
+	if src.StartsAt != nil {
+		ev.StartsAt = *src.StartsAt
+	}
+	if src.IsFree != nil && *src.IsFree {
+		zero := int64(0)
+		ev.IsFree, ev.MinPrice, ev.MaxPrice = true, &zero, nil
+	} else if src.IsFree == nil && ev.MinPrice != nil && *ev.MinPrice == 0 {
+		// старые сообщения без is_free: нулевой ценой отмечались и бесплатные события, и события без цены
+		ev.MinPrice, ev.MaxPrice = nil, nil
+	}
+
+	// валюта сеанса по умолчанию совпадает с валютой события
+	for _, s := range src.Sessions {
+		session := &domain.EventSession{
+			StartsAt:       *s.StartsAt,
+			EndsAt:         s.EndsAt,
+			MinPrice:       minorPtrFromFloatPtr(s.Price),
+			MaxPrice:       minorPtrFromFloatPtr(s.PriceMax),
+			Currency:       currencyPtrOrNil(s.Currency),
+			SeatsAvailable: int32PtrFromIntPtr(s.Seats),
+		}
+		if session.Currency == nil {
+			session.Currency = ev.Currency
+		}
+		ev.Sessions = append(ev.Sessions, session)
+	}
+	ev.EnsureSessions()
 
 	return ev
 }
 
+// ToCategories объединяет устаревшее поле category и список categories
+func ToCategories(src models.Event) []string {
+	categories := make([]string, 0, len(src.Categories)+1)
+	if c := strings.TrimSpace(src.Category); c != "" {
+		categories = append(categories, c)
+	}
+	for _, c := range src.Categories {
+		if c = strings.TrimSpace(c); c != "" {
+			categories = append(categories, c)
+		}
+	}
+	return categories
+}
+
 // --- helpers ---
 
 func strPtrOrNil(s string) *string {
@@ -62,17 +95,25 @@ func strPtrOrNil(s string) *string {
 	return &v
 }
 
-func int32PtrFromIntPtr(p *int) *int32 {
+func currencyPtrOrNil(s string) *string {
+	if s = domain.NormalizeCurrency(s); s == "" {
+		return nil
+	}
+	return &s
+}
+
+func minorPtrFromFloatPtr(p *float64) *int64 {
 	if p == nil {
 		return nil
 	}
-	v := int32(*p)
+	v := domain.PriceToMinor(*p)
 	return &v
 }
 
-func float64Ptr(v float64) *float64 {
-	// если хочешь игнорировать 0 как "нет значения" — раскомментируй:
-	// if v == 0 { return nil }
-	x := v
-	return &x
+func int32PtrFromIntPtr(p *int) *int32 {
+	if p == nil {
+		return nil
+	}
+	v := int32(*p)
+	return &v
 }
@@ -0,0 +1,46 @@
+package events
+
+import (
+	"context"
+	kafkaClient "github.com/M1steryO/RelocatorEvents/events/internal/client/kafka"
+	"github.com/M1steryO/RelocatorEvents/events/internal/consumer/kafka/events/converters"
+	"github.com/M1steryO/RelocatorEvents/events/internal/consumer/kafka/events/models"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"log/slog"
+)
+
+// HandleBatch сохраняет валидные сообщения пачкой, невалидные возвращаются как Permanent-ошибки
+func (e *EventsHandler) HandleBatch(ctx context.Context, msgs []*kafka.Message) ([]error, error) {
+	errs := make([]error, len(msgs))
+	items := make([]*domain.UpsertItem, 0, len(msgs))
+
+	for i, msg := range msgs {
+		envelope, err := models.DecodeEnvelope(msg.Value)
+		if err == nil {
+			err = envelope.Validate()
+		}
+		if err != nil {
+			logger.Warn("invalid event message", slog.Any("partition", msg.TopicPartition), slog.String("err", err.Error()))
+			errs[i] = kafkaClient.Permanent(err)
+			continue
+		}
+
+		event := *envelope.Event
+		items = append(items, &domain.UpsertItem{
+			Event:      converters.ToDomainEvent(event),
+			Categories: converters.ToCategories(event),
+		})
+	}
+
+	if len(items) == 0 {
+		return errs, nil
+	}
+
+	if _, err := e.service.UpsertBatch(ctx, items); err != nil {
+		logger.Error("Error upserting events batch: ", err.Error())
+		return nil, err
+	}
+	return errs, nil
+}
@@ -2,29 +2,42 @@ package events
 
 import (
 	"context"
-	"encoding/json"
+	kafkaClient "github.com/M1steryO/RelocatorEvents/events/internal/client/kafka"
 	"github.com/M1steryO/RelocatorEvents/events/internal/consumer/kafka/events/converters"
 	"github.com/M1steryO/RelocatorEvents/events/internal/consumer/kafka/events/models"
 	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"log/slog"
 )
 
 func (e *EventsHandler) Handle(ctx context.Context, msg []byte, _ kafka.TopicPartition, consumerNumber int) error {
-	var event models.Event
-
-	err := json.Unmarshal(msg, &event)
+	envelope, err := models.DecodeEnvelope(msg)
 	if err != nil {
 		logger.Error("Error unmarshalling event: ", err.Error())
-		return err
+		return kafkaClient.Permanent(err)
+	}
+
+	if err = envelope.Validate(); err != nil {
+		logger.Warn("invalid event message",
+			slog.Int("schema_version", envelope.SchemaVersion),
+			slog.String("source", envelope.Source),
+			slog.String("external_id", envelope.ExternalID),
+			slog.String("err", err.Error()),
+		)
+		return kafkaClient.Permanent(err)
+	}
+	if envelope.SchemaVersion == models.SchemaVersionLegacy {
+		logger.Warn("event message without envelope", slog.String("link", envelope.Event.Link))
 	}
 
+	event := *envelope.Event
 	ev := converters.ToDomainEvent(event)
 
-	id, err := e.service.Create(ctx, ev, event.Category)
+	id, err := e.service.Upsert(ctx, ev, converters.ToCategories(event))
 	if err != nil {
-		logger.Error("Error creating event: ", err.Error())
+		logger.Error("Error upserting event: ", err.Error())
 		return err
 	}
-	logger.Info("Created event: ", "id", id)
+	logger.Info("Upserted event: ", "id", id, "source", envelope.Source, "external_id", envelope.ExternalID)
 	return nil
 }
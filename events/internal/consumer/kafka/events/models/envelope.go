@@ -0,0 +1,51 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// SchemaVersionLegacy - сообщение без конверта, голый Event (старые версии парсера)
+	SchemaVersionLegacy = 0
+	SchemaVersionV1     = 1
+
+	legacySource = "legacy"
+)
+
+// Envelope - конверт сообщения топика events.new, схема в api/kafka/events_new.v1.schema.json
+type Envelope struct {
+	SchemaVersion int       `json:"schema_version"`
+	Source        string    `json:"source"`
+	ExternalID    string    `json:"external_id"`
+	ProducedAt    time.Time `json:"produced_at"`
+	Event         *Event    `json:"event"`
+}
+
+func DecodeEnvelope(msg []byte) (*Envelope, error) {
+	var probe struct {
+		SchemaVersion *int `json:"schema_version"`
+	}
+	if err := json.Unmarshal(msg, &probe); err != nil {
+		return nil, err
+	}
+
+	if probe.SchemaVersion == nil {
+		var event Event
+		if err := json.Unmarshal(msg, &event); err != nil {
+			return nil, err
+		}
+		return &Envelope{
+			SchemaVersion: SchemaVersionLegacy,
+			Source:        legacySource,
+			ExternalID:    event.Link,
+			Event:         &event,
+		}, nil
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(msg, &envelope); err != nil {
+		return nil, err
+	}
+	return &envelope, nil
+}
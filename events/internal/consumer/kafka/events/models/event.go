@@ -3,19 +3,33 @@ package models
 import "time"
 
 type Event struct {
-	Link        string    `json:"link"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Country     string    `json:"country"`
-	Category    string    `json:"category"`
-	StartsAt    time.Time `json:"starts_at"`
-	Venue       string    `json:"venue"`
-	City        string    `json:"city"`
-	Price       *float64  `json:"price"`
-	Currency    string    `json:"currency"`
-	Age         *int      `json:"age"` // nullable
-	Address     string    `json:"address"`
-	Longitude   float64   `json:"longitude"`
-	Latitude    float64   `json:"latitude"`
-	ImgURL      string    `json:"img_url"`
+	Link        string     `json:"link"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Country     string     `json:"country"`
+	Category    string     `json:"category"`
+	Categories  []string   `json:"categories"`
+	StartsAt    *time.Time `json:"starts_at"`
+	Venue       string     `json:"venue"`
+	City        string     `json:"city"`
+	Price       *float64   `json:"price"`
+	PriceMax    *float64   `json:"price_max"`
+	IsFree      *bool      `json:"is_free"`
+	Currency    string     `json:"currency"`
+	Age         *int       `json:"age"` // nullable
+	Address     string     `json:"address"`
+	Longitude   *float64   `json:"longitude"`
+	Latitude    *float64   `json:"latitude"`
+	ImgURL      string     `json:"img_url"`
+	Sessions    []Session  `json:"sessions"`
+}
+
+// Session - отдельная дата события. Если sessions пусто, сеанс один и берётся из starts_at/price
+type Session struct {
+	StartsAt *time.Time `json:"starts_at"`
+	EndsAt   *time.Time `json:"ends_at"`
+	Price    *float64   `json:"price"`
+	PriceMax *float64   `json:"price_max"`
+	Currency string     `json:"currency"`
+	Seats    *int       `json:"seats"`
 }
@@ -0,0 +1,181 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Ограничения повторяют колонки events и event_address
+const (
+	maxLinkLen     = 255
+	maxTitleLen    = 255
+	maxImageURLLen = 255
+	maxVenueLen    = 255
+	maxCityLen     = 100
+	maxCountryLen  = 100
+	maxAddressLen  = 512
+	maxCategoryLen = 255
+	currencyLen    = 3
+	maxAge         = 99
+	maxSessions    = 500
+
+	maxSourceLen     = 100
+	maxExternalIDLen = 255
+)
+
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Errors))
+	for _, f := range e.Errors {
+		parts = append(parts, f.Field+": "+f.Reason)
+	}
+	return "invalid event message: " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) add(field, reason string) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Reason: reason})
+}
+
+// Validate проверяет конверт и событие. Координаты 0/0 старые парсеры присылали вместо null,
+// поэтому они не считаются ошибкой, а сбрасываются.
+func (e *Envelope) Validate() error {
+	verr := &ValidationError{}
+
+	switch e.SchemaVersion {
+	case SchemaVersionLegacy:
+	case SchemaVersionV1:
+		requireString(verr, "source", e.Source, maxSourceLen)
+		requireString(verr, "external_id", e.ExternalID, maxExternalIDLen)
+		if e.ProducedAt.IsZero() {
+			verr.add("produced_at", "required")
+		}
+	default:
+		verr.add("schema_version", fmt.Sprintf("unsupported version %d", e.SchemaVersion))
+		return verr
+	}
+
+	if e.Event == nil {
+		verr.add("event", "required")
+		return verr
+	}
+	e.Event.validate(verr, "event.")
+
+	if len(verr.Errors) > 0 {
+		return verr
+	}
+	return nil
+}
+
+func (e *Event) validate(verr *ValidationError, prefix string) {
+	requireString(verr, prefix+"link", e.Link, maxLinkLen)
+	if u, err := url.Parse(strings.TrimSpace(e.Link)); e.Link != "" && (err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "") {
+		verr.add(prefix+"link", "must be an absolute http(s) url")
+	}
+	requireString(verr, prefix+"title", e.Title, maxTitleLen)
+	requireString(verr, prefix+"country", e.Country, maxCountryLen)
+	requireString(verr, prefix+"city", e.City, maxCityLen)
+	optionalString(verr, prefix+"address", e.Address, maxAddressLen)
+	optionalString(verr, prefix+"venue", e.Venue, maxVenueLen)
+	optionalString(verr, prefix+"img_url", e.ImgURL, maxImageURLLen)
+
+	if len(e.Sessions) == 0 && (e.StartsAt == nil || e.StartsAt.IsZero()) {
+		verr.add(prefix+"starts_at", "required")
+	}
+	if len(e.Sessions) > maxSessions {
+		verr.add(prefix+"sessions", fmt.Sprintf("must contain at most %d items", maxSessions))
+	}
+	for i := range e.Sessions {
+		e.Sessions[i].validate(verr, fmt.Sprintf("%ssessions[%d].", prefix, i))
+	}
+
+	if c := strings.TrimSpace(e.Currency); c != "" && utf8.RuneCountInString(c) != currencyLen {
+		verr.add(prefix+"currency", "must be a 3-letter code")
+	}
+	validatePrice(verr, prefix, e.Price, e.PriceMax)
+	if e.Age != nil && (*e.Age < 0 || *e.Age > maxAge) {
+		verr.add(prefix+"age", fmt.Sprintf("must be between 0 and %d", maxAge))
+	}
+
+	categories := 0
+	if strings.TrimSpace(e.Category) != "" {
+		categories++
+		optionalString(verr, prefix+"category", e.Category, maxCategoryLen)
+	}
+	for i, c := range e.Categories {
+		if strings.TrimSpace(c) == "" {
+			continue
+		}
+		categories++
+		optionalString(verr, fmt.Sprintf("%scategories[%d]", prefix, i), c, maxCategoryLen)
+	}
+	if categories == 0 {
+		verr.add(prefix+"category", "at least one category is required")
+	}
+
+	e.validateCoordinates(verr, prefix)
+}
+
+func (s *Session) validate(verr *ValidationError, prefix string) {
+	if s.StartsAt == nil || s.StartsAt.IsZero() {
+		verr.add(prefix+"starts_at", "required")
+	} else if s.EndsAt != nil && !s.EndsAt.After(*s.StartsAt) {
+		verr.add(prefix+"ends_at", "must be after starts_at")
+	}
+	if c := strings.TrimSpace(s.Currency); c != "" && utf8.RuneCountInString(c) != currencyLen {
+		verr.add(prefix+"currency", "must be a 3-letter code")
+	}
+	validatePrice(verr, prefix, s.Price, s.PriceMax)
+	if s.Seats != nil && *s.Seats < 0 {
+		verr.add(prefix+"seats", "must be >= 0")
+	}
+}
+
+func (e *Event) validateCoordinates(verr *ValidationError, prefix string) {
+	if e.Latitude != nil && e.Longitude != nil && *e.Latitude == 0 && *e.Longitude == 0 {
+		e.Latitude, e.Longitude = nil, nil
+		return
+	}
+	if (e.Latitude == nil) != (e.Longitude == nil) {
+		verr.add(prefix+"latitude", "latitude and longitude must be set together")
+		return
+	}
+	if e.Latitude != nil && (*e.Latitude < -90 || *e.Latitude > 90) {
+		verr.add(prefix+"latitude", "must be between -90 and 90")
+	}
+	if e.Longitude != nil && (*e.Longitude < -180 || *e.Longitude > 180) {
+		verr.add(prefix+"longitude", "must be between -180 and 180")
+	}
+}
+
+func validatePrice(verr *ValidationError, prefix string, price, priceMax *float64) {
+	if price != nil && *price < 0 {
+		verr.add(prefix+"price", "must be >= 0")
+	}
+	if priceMax != nil && (*priceMax < 0 || (price != nil && *priceMax < *price)) {
+		verr.add(prefix+"price_max", "must be >= price")
+	}
+}
+
+func requireString(verr *ValidationError, field, value string, maxLen int) {
+	if strings.TrimSpace(value) == "" {
+		verr.add(field, "required")
+		return
+	}
+	optionalString(verr, field, value, maxLen)
+}
+
+func optionalString(verr *ValidationError, field, value string, maxLen int) {
+	if utf8.RuneCountInString(strings.TrimSpace(value)) > maxLen {
+		verr.add(field, fmt.Sprintf("must be at most %d characters", maxLen))
+	}
+}
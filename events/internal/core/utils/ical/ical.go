@@ -0,0 +1,120 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+
+	// строки длиннее 75 октетов переносятся (RFC 5545, 3.1)
+	maxLineOctets = 75
+	dateTimeUTC   = "20060102T150405Z"
+)
+
+type Calendar struct {
+	ProdId string
+	// Name - название календаря в клиенте (X-WR-CALNAME), для подписки
+	Name string
+	// RefreshInterval - как часто клиенту перезапрашивать подписку, 0 - не указывать
+	RefreshInterval time.Duration
+	Events          []*Event
+}
+
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         *time.Time
+	Stamp       time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Geo         *Geo
+}
+
+type Geo struct {
+	Lat float64
+	Lon float64
+}
+
+// Marshal собирает VCALENDAR с переводами строк CRLF, время пишется в UTC
+func (c *Calendar) Marshal() []byte {
+	w := &writer{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", c.ProdId)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME", escape(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		minutes := int(c.RefreshInterval.Minutes())
+		w.line("REFRESH-INTERVAL;VALUE=DURATION", fmt.Sprintf("PT%dM", minutes))
+		w.line("X-PUBLISHED-TTL", fmt.Sprintf("PT%dM", minutes))
+	}
+
+	for _, e := range c.Events {
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", e.UID)
+		w.line("DTSTAMP", e.Stamp.UTC().Format(dateTimeUTC))
+		w.line("DTSTART", e.Start.UTC().Format(dateTimeUTC))
+		if e.End != nil && e.End.After(e.Start) {
+			w.line("DTEND", e.End.UTC().Format(dateTimeUTC))
+		}
+		w.line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			w.line("DESCRIPTION", escape(e.Description))
+		}
+		if e.Location != "" {
+			w.line("LOCATION", escape(e.Location))
+		}
+		if e.Geo != nil {
+			w.line("GEO", fmt.Sprintf("%.6f;%.6f", e.Geo.Lat, e.Geo.Lon))
+		}
+		if e.URL != "" {
+			w.line("URL", e.URL)
+		}
+		w.line("END", "VEVENT")
+	}
+
+	w.line("END", "VCALENDAR")
+	return []byte(w.sb.String())
+}
+
+type writer struct {
+	sb strings.Builder
+}
+
+func (w *writer) line(name, value string) {
+	line := name + ":" + value
+	limit := maxLineOctets
+	for len(line) > limit {
+		// режем по границе руны, чтобы не разорвать utf-8 символ
+		cut := limit
+		for cut > 0 && !runeStart(line[cut]) {
+			cut--
+		}
+		w.sb.WriteString(line[:cut])
+		w.sb.WriteString("\r\n ")
+		line = line[cut:]
+		// строка продолжения начинается с пробела, он тоже занимает октет
+		limit = maxLineOctets - 1
+	}
+	w.sb.WriteString(line)
+	w.sb.WriteString("\r\n")
+}
+
+func runeStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escape экранирует значение типа TEXT
+func escape(s string) string {
+	return escaper.Replace(s)
+}
@@ -0,0 +1,139 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "Квиз, бар; зал", want: `Квиз\, бар\; зал`},
+		{in: `C:\events`, want: `C:\\events`},
+		{in: "первая\r\nвторая\nтретья\rчетвёртая", want: `первая\nвторая\nтретья\nчетвёртая`},
+		{in: `\,;`, want: `\\\,\;`},
+	}
+
+	for _, tt := range tests {
+		if got := escape(tt.in); got != tt.want {
+			t.Errorf("escape(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLineFoldsOnRuneBoundaries(t *testing.T) {
+	summary := escape(strings.Repeat("Большой летний фестиваль уличной еды, ", 5))
+
+	w := &writer{}
+	w.line("SUMMARY", summary)
+	out := w.sb.String()
+
+	if !strings.HasSuffix(out, "\r\n") {
+		t.Fatalf("output does not end with CRLF: %q", out)
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+	if len(lines) < 2 {
+		t.Fatalf("expected folded output, got %q", out)
+	}
+
+	var unfolded strings.Builder
+	for i, line := range lines {
+		if len(line) > maxLineOctets {
+			t.Errorf("line %d is %d octets, want <= %d", i, len(line), maxLineOctets)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line %d splits a utf-8 character: %q", i, line)
+		}
+		if i > 0 {
+			if !strings.HasPrefix(line, " ") {
+				t.Fatalf("continuation line %d does not start with a space: %q", i, line)
+			}
+			line = line[1:]
+		}
+		unfolded.WriteString(line)
+	}
+
+	if want := "SUMMARY:" + summary; unfolded.String() != want {
+		t.Errorf("unfolded = %q, want %q", unfolded.String(), want)
+	}
+}
+
+func TestLineShortIsNotFolded(t *testing.T) {
+	w := &writer{}
+	w.line("UID", "event-1@example.com")
+	if got, want := w.sb.String(), "UID:event-1@example.com\r\n"; got != want {
+		t.Errorf("line() = %q, want %q", got, want)
+	}
+}
+
+func TestMarshalGolden(t *testing.T) {
+	start := time.Date(2026, 10, 18, 19, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	end := start.Add(2 * time.Hour)
+	stamp := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	calendar := &Calendar{
+		ProdId:          "-//Relocator//Events//RU",
+		Name:            "Мои события",
+		RefreshInterval: time.Hour,
+		Events: []*Event{
+			{
+				UID:         "event-1-session-1@example.com",
+				Start:       start,
+				End:         &end,
+				Stamp:       stamp,
+				Summary:     "Квиз, 2 тура; финал",
+				Description: "Команды до 6 человек\nВход по записи",
+				Location:    "Бар \\Кот\\",
+				URL:         "https://example.com/events/1",
+				Geo:         &Geo{Lat: 55.751244, Lon: 37.618423},
+			},
+			{
+				UID:     "event-2-session-3@example.com",
+				Start:   start,
+				End:     &start,
+				Stamp:   stamp,
+				Summary: "Без конца",
+			},
+		},
+	}
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Relocator//Events//RU",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Мои события",
+		"REFRESH-INTERVAL;VALUE=DURATION:PT60M",
+		"X-PUBLISHED-TTL:PT60M",
+		"BEGIN:VEVENT",
+		"UID:event-1-session-1@example.com",
+		"DTSTAMP:20261001T120000Z",
+		"DTSTART:20261018T160000Z",
+		"DTEND:20261018T180000Z",
+		`SUMMARY:Квиз\, 2 тура\; финал`,
+		// 75 октетов: перенос перед последней буквой, а не посреди её двух байт
+		`DESCRIPTION:Команды до 6 человек\nВход по запис`,
+		" и",
+		`LOCATION:Бар \\Кот\\`,
+		"GEO:55.751244;37.618423",
+		"URL:https://example.com/events/1",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:event-2-session-3@example.com",
+		"DTSTAMP:20261001T120000Z",
+		"DTSTART:20261018T160000Z",
+		"SUMMARY:Без конца",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	if got := string(calendar.Marshal()); got != want {
+		t.Errorf("Marshal() mismatch\n got: %q\nwant: %q", got, want)
+	}
+}
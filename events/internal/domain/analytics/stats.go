@@ -0,0 +1,51 @@
+package analytics
+
+import (
+	"errors"
+	"time"
+)
+
+type Dimension string
+
+const (
+	DimensionEvent    Dimension = "event"
+	DimensionCity     Dimension = "city"
+	DimensionCategory Dimension = "category"
+)
+
+// MaxStatsRange - наибольший диапазон дат в одном запросе статистики
+const MaxStatsRange = 366 * 24 * time.Hour
+
+var ErrInvalidStatsParams = errors.New("invalid analytics params")
+
+// StatsParams - статистика по одному ключу измерения за дни [From, To)
+type StatsParams struct {
+	Dimension Dimension
+	Key       string
+	From      time.Time
+	To        time.Time
+}
+
+type DailyStats struct {
+	Day           time.Time
+	Views         int64
+	UniqueViewers int64
+	// ListViews - открытия карточки из списка, числитель CTR
+	ListViews   int64
+	Impressions int64
+	Favorites   int64
+}
+
+// CTR - доля показов в списке, после которых событие открыли
+func (s *DailyStats) CTR() float64 {
+	if s.Impressions == 0 {
+		return 0
+	}
+	return float64(s.ListViews) / float64(s.Impressions)
+}
+
+type Stats struct {
+	// Total.UniqueViewers считается по сырым просмотрам, а не суммой по дням
+	Total *DailyStats
+	Days  []*DailyStats
+}
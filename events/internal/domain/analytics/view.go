@@ -0,0 +1,34 @@
+package analytics
+
+import "time"
+
+type ViewSource string
+
+const (
+	SourceUnknown   ViewSource = "unknown"
+	SourceList      ViewSource = "list"
+	SourceDeepLink  ViewSource = "deep_link"
+	SourceMap       ViewSource = "map"
+	SourceFavorites ViewSource = "favorites"
+)
+
+// View - открытие карточки события пользователем
+type View struct {
+	EventId  int64
+	UserId   int64
+	Source   ViewSource
+	ViewedAt time.Time
+}
+
+// Impressions - сколько раз событие показали в списке за день (UTC)
+type Impressions struct {
+	Day     time.Time
+	EventId int64
+	Count   int64
+}
+
+// Day - начало суток t в UTC, по ним считаются дневные агрегаты
+func Day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,24 @@
+package events
+
+import "time"
+
+const (
+	CalendarProdId = "-//Relocator//Events//RU"
+	CalendarName   = "Relocator Events"
+	// CalendarUIDDomain - правая часть UID у VEVENT, UID должен быть глобально уникальным
+	CalendarUIDDomain = "events.relocator"
+
+	// CalendarFeedRefresh - как часто календарному клиенту обновлять подписку
+	CalendarFeedRefresh = time.Hour
+	// CalendarFeedHistory - сколько прошедших событий остаётся в ленте
+	CalendarFeedHistory = 30 * 24 * time.Hour
+)
+
+// CalendarFeed - личная подписка пользователя на избранные события и события с RSVP
+type CalendarFeed struct {
+	Token     string
+	CreatedAt time.Time
+	// HttpURL и WebcalURL заполняет сервис из базового адреса ленты
+	HttpURL   string
+	WebcalURL string
+}
@@ -0,0 +1,6 @@
+package events
+
+type CategoryAlias struct {
+	Alias    string
+	Category *EventCategory
+}
@@ -0,0 +1,115 @@
+package events
+
+import (
+	"math"
+)
+
+// координаты в БД хранятся как DECIMAL(10, 7)
+const coordinateEpsilon = 1e-6
+
+type FieldChange struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// ApplyChanges переносит в e заполненные поля src, которые отличаются от текущих,
+// и возвращает список изменений. Пустые поля src не затирают существующие значения.
+func (e *Event) ApplyChanges(src *Event) []FieldChange {
+	var changes []FieldChange
+
+	if src.Title != "" && src.Title != e.Title {
+		changes = append(changes, FieldChange{Field: "title", Old: e.Title, New: src.Title})
+		e.Title = src.Title
+	}
+	applyString("description", &e.Description, src.Description, &changes)
+	applyInt32("min_age", &e.MinAge, src.MinAge, &changes)
+	applyInt32("seats_total", &e.SeatsTotal, src.SeatsTotal, &changes)
+	applyInt64("min_price", &e.MinPrice, src.MinPrice, &changes)
+	applyInt64("max_price", &e.MaxPrice, src.MaxPrice, &changes)
+	// бесплатность снимается только вместе с новой ценой
+	if src.IsFree != e.IsFree && (src.IsFree || src.MinPrice != nil) {
+		changes = append(changes, FieldChange{Field: "is_free", Old: e.IsFree, New: src.IsFree})
+		e.IsFree = src.IsFree
+	}
+	applyString("currency", &e.Currency, src.Currency, &changes)
+	applyString("image_url", &e.ImageUrl, src.ImageUrl, &changes)
+
+	if src.Type != e.Type {
+		changes = append(changes, FieldChange{Field: "type", Old: e.Type.String(), New: src.Type.String()})
+		e.Type = src.Type
+	}
+	// starts_at не сравнивается: время начала берётся из сеансов (event_sessions)
+
+	if src.Address != nil {
+		if e.Address == nil {
+			changes = append(changes, FieldChange{Field: "address", New: src.Address.FullAddress})
+			e.Address = src.Address
+		} else {
+			changes = append(changes, e.Address.applyChanges(src.Address)...)
+		}
+	}
+
+	return changes
+}
+
+func (a *EventAddress) applyChanges(src *EventAddress) []FieldChange {
+	var changes []FieldChange
+
+	applyPlainString("address.full_address", &a.FullAddress, src.FullAddress, &changes)
+	applyPlainString("address.country", &a.Country, src.Country, &changes)
+	applyPlainString("address.city", &a.City, src.City, &changes)
+	applyString("address.venue_name", &a.VenueName, src.VenueName, &changes)
+	applyString("address.district", &a.District, src.District, &changes)
+	applyString("address.postal_code", &a.PostalCode, src.PostalCode, &changes)
+	applyCoordinate("address.latitude", &a.Latitude, src.Latitude, &changes)
+	applyCoordinate("address.longitude", &a.Longitude, src.Longitude, &changes)
+
+	return changes
+}
+
+func applyPlainString(field string, dst *string, src string, changes *[]FieldChange) {
+	if src != "" && src != *dst {
+		*changes = append(*changes, FieldChange{Field: field, Old: *dst, New: src})
+		*dst = src
+	}
+}
+
+func applyString(field string, dst **string, src *string, changes *[]FieldChange) {
+	if src == nil || (*dst != nil && **dst == *src) {
+		return
+	}
+	*changes = append(*changes, FieldChange{Field: field, Old: derefOrNil(*dst), New: *src})
+	*dst = src
+}
+
+func applyInt32(field string, dst **int32, src *int32, changes *[]FieldChange) {
+	if src == nil || (*dst != nil && **dst == *src) {
+		return
+	}
+	*changes = append(*changes, FieldChange{Field: field, Old: derefOrNil(*dst), New: *src})
+	*dst = src
+}
+
+func applyInt64(field string, dst **int64, src *int64, changes *[]FieldChange) {
+	if src == nil || (*dst != nil && **dst == *src) {
+		return
+	}
+	*changes = append(*changes, FieldChange{Field: field, Old: derefOrNil(*dst), New: *src})
+	*dst = src
+}
+
+func applyCoordinate(field string, dst **float64, src *float64, changes *[]FieldChange) {
+	if src == nil || (*dst != nil && math.Abs(**dst-*src) < coordinateEpsilon) {
+		return
+	}
+	*changes = append(*changes, FieldChange{Field: field, Old: derefOrNil(*dst), New: *src})
+	*dst = src
+}
+
+func derefOrNil[T any](v *T) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -0,0 +1,15 @@
+package events
+
+import "time"
+
+// Cursor - позиция последнего события страницы для keyset-пагинации
+type Cursor struct {
+	Sort string `json:"s"`
+	// Filters - отпечаток фильтров запроса, с другими фильтрами курсор недействителен
+	Filters string  `json:"f,omitempty"`
+	Key     *string `json:"k,omitempty"`
+	Id      int64   `json:"id"`
+	// At - момент, от которого считался ключ сортировки (оценка recommended, ближайший сеанс в RSVP),
+	// чтобы страницы не сдвигались
+	At *time.Time `json:"at,omitempty"`
+}
@@ -5,4 +5,21 @@ import "errors"
 var (
 	ErrEventNotFound = errors.New("event not found")
 	ErrEventExists   = errors.New("event already exists")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrStaleCursor   = errors.New("cursor is stale, request the first page again")
+	ErrInvalidSort   = errors.New("invalid sort")
+
+	ErrInvalidDateFilter = errors.New("invalid date filter")
+	ErrInvalidSession    = errors.New("session must end after it starts")
+	ErrInvalidPriceRange = errors.New("max price must not be less than min price")
+
+	ErrInvalidCurrencyRates = errors.New("invalid currency rates")
+
+	ErrInvalidRsvpStatus = errors.New("rsvp status must be going, interested or not_going")
+	ErrEventFinished     = errors.New("event has already finished")
+
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrCategoryAliasNotFound = errors.New("category alias not found")
+
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
 )
@@ -31,13 +31,23 @@ type Event struct {
 	ReviewsCount   *int32
 	RatingsCount   *int32
 	MinAge         *int32
-	SeatsAvailable *int32
+	SeatsTotal     *int32 // вместимость, её задают импорт и админка; nil - без ограничений
+	SeatsAvailable *int32 // оставшиеся места: SeatsTotal - GoingCount
 	Type           EventType
-	MinPrice       *int32
+	MinPrice       *int64
+	MaxPrice       *int64
+	IsFree         bool
 	Currency       *string
 	StartsAt       time.Time
 	ImageUrl       *string
 	Address        *EventAddress
+	Sessions       []*EventSession
+	FavoritesCount int32
+	GoingCount     int32
+	IsFavorite     bool
+	Highlight      *string
+	DistanceM      *float64
+	Recommendation *Recommendation
 	CreatedAt      time.Time
 	UpdatedAt      *time.Time
 }
@@ -61,14 +71,17 @@ type EventCategory struct {
 	Code  string
 }
 
+// FiltersData - доступные значения фильтров, цены в минорных единицах валюты Currency
 type FiltersData struct {
-	MinPrice   *int32
-	MaxPrice   *int32
+	MinPrice   *int64
+	MaxPrice   *int64
+	Currency   string
 	Cities     []string
 	Categories []*EventCategory
 }
 
 type EventsList struct {
-	Data    []*Event
-	Filters *FiltersData
+	Data       []*Event
+	Filters    *FiltersData
+	NextCursor *string
 }
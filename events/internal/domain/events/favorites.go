@@ -0,0 +1,13 @@
+package events
+
+// FavoritesCursorSort отличает курсор избранного от курсоров общего списка
+const FavoritesCursorSort = "favorites"
+
+// FavoritesParams - параметры списка избранного. По умолчанию прошедшие события скрываются.
+type FavoritesParams struct {
+	IncludePast bool
+
+	Limit  *int64
+	Cursor *string
+	After  *Cursor
+}
@@ -0,0 +1,32 @@
+package events
+
+import "math"
+
+const (
+	// количество ячеек кластеризации на ширину одного тайла карты
+	clusterCellsPerTile = 4
+	// сколько id событий отдавать в кластере
+	MapClusterSampleSize = 5
+	// сколько кластеров отдавать максимум, при большом zoom остаются самые крупные
+	MapClustersLimit = 500
+)
+
+type MapViewport struct {
+	MinLat float64
+	MinLon float64
+	MaxLat float64
+	MaxLon float64
+	Zoom   int32
+}
+
+// CellSize - размер ячейки сетки в градусах для текущего zoom
+func (v *MapViewport) CellSize() float64 {
+	return 360 / math.Pow(2, float64(v.Zoom)) / clusterCellsPerTile
+}
+
+type MapCluster struct {
+	Count     int64
+	Latitude  float64
+	Longitude float64
+	SampleIds []int64
+}
@@ -0,0 +1,42 @@
+package events
+
+import "time"
+
+const PopularSort = "popular"
+
+// веса сигналов популярности
+const (
+	PopularityViewWeight       = 1
+	PopularityFavoriteWeight   = 3
+	PopularityInterestedWeight = 2
+	PopularityGoingWeight      = 5
+	PopularityReviewWeight     = 4
+)
+
+const (
+	// PopularityWindow - более старые сигналы при затухании почти ничего не дают и не учитываются
+	PopularityWindow = 30 * 24 * time.Hour
+	// TrendingWindow - окно для week_score и счётчиков тренда
+	TrendingWindow = 7 * 24 * time.Hour
+)
+
+// PopularityParams - параметры пересчёта популярности
+type PopularityParams struct {
+	At       time.Time
+	HalfLife time.Duration
+}
+
+// TrendingEvent - событие из тренда города со счётчиками сигналов за TrendingWindow
+type TrendingEvent struct {
+	Event     *Event
+	Score     float64
+	Views     int32
+	Favorites int32
+	Rsvps     int32
+	Reviews   int32
+}
+
+type TrendingParams struct {
+	City  string
+	Limit *int64
+}
@@ -0,0 +1,85 @@
+package events
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// MinorUnits - число минорных единиц в единице валюты (центы, тетри, копейки).
+// Цены хранятся в минорных единицах, API принимает и отдаёт целые единицы валюты.
+const MinorUnits = 100
+
+// DefaultCurrency - базовая валюта курсов и валюта пользователя, если её не удалось определить
+const DefaultCurrency = "USD"
+
+// CurrencyRate - сколько единиц валюты дают за 1 USD
+type CurrencyRate struct {
+	Currency  string
+	Rate      float64
+	UpdatedAt time.Time
+}
+
+var countryCurrencies = map[string]string{
+	"грузия": "GEL", "georgia": "GEL",
+	"армения": "AMD", "armenia": "AMD",
+	"сербия": "RSD", "serbia": "RSD",
+	"черногория": "EUR", "montenegro": "EUR",
+	"россия": "RUB", "russia": "RUB",
+	"турция": "TRY", "turkey": "TRY",
+	"казахстан": "KZT", "kazakhstan": "KZT",
+	"узбекистан": "UZS", "uzbekistan": "UZS",
+	"киргизия": "KGS", "кыргызстан": "KGS", "kyrgyzstan": "KGS",
+	"азербайджан": "AZN", "azerbaijan": "AZN",
+	"беларусь": "BYN", "belarus": "BYN",
+	"молдова": "MDL", "moldova": "MDL",
+	"кипр": "EUR", "cyprus": "EUR",
+	"оаэ": "AED", "uae": "AED",
+}
+
+// ResolveCurrency определяет валюту пользователя: явно запрошенная, иначе валюта страны из профиля
+func ResolveCurrency(requested *string, country string) string {
+	if requested != nil {
+		if c := NormalizeCurrency(*requested); c != "" {
+			return c
+		}
+	}
+	if c, ok := countryCurrencies[normalizePlace(country)]; ok {
+		return c
+	}
+	return DefaultCurrency
+}
+
+func NormalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// PriceToMinor переводит цену из единиц валюты в минорные единицы
+func PriceToMinor(price float64) int64 {
+	return int64(math.Round(price * MinorUnits))
+}
+
+// MinorFromMajor переводит цену из API (целые единицы валюты) в минорные единицы
+func MinorFromMajor(price *int32) *int64 {
+	if price == nil {
+		return nil
+	}
+	v := int64(*price) * MinorUnits
+	return &v
+}
+
+// MajorFromMinor округляет цену до целых единиц валюты: нижняя граница вниз, верхняя вверх,
+// чтобы диапазон из API покрывал исходные цены
+func MajorFromMinor(price *int64, roundUp bool) *int32 {
+	if price == nil {
+		return nil
+	}
+	v := float64(*price) / MinorUnits
+	if roundUp {
+		v = math.Ceil(v)
+	} else {
+		v = math.Floor(v)
+	}
+	major := int32(min(v, math.MaxInt32))
+	return &major
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const RecommendedSort = "recommended"
+
+// веса составляющих оценки рекомендаций, в сумме 1
+const (
+	RecommendInterestWeight  = 0.4
+	RecommendProximityWeight = 0.25
+	RecommendSoonWeight      = 0.2
+	RecommendRatingWeight    = 0.15
+)
+
+const (
+	// RecommendMaxInterests - сколько совпавших интересов дают полный вклад интересов
+	RecommendMaxInterests = 2
+	// RecommendProximityScaleM - на таком расстоянии вклад близости падает вдвое
+	RecommendProximityScaleM = 5000
+	// RecommendSoonScale - за это время вклад близости по времени падает в e раз
+	RecommendSoonScale = 7 * 24 * time.Hour
+	// RecommendMaxRating - рейтинг, дающий полный вклад: оценки в отзывах от 1 до 10
+	RecommendMaxRating = 10
+)
+
+const (
+	soonReasonWithin    = 48 * time.Hour
+	nearbyReasonWithinM = 10000
+	ratingReasonFrom    = 8
+)
+
+// Recommendation - оценка события для сортировки recommended и её объяснение
+type Recommendation struct {
+	Score            float64
+	MatchedInterests []string
+	// NextStartsAt - ближайший будущий сеанс относительно момента расчёта
+	NextStartsAt *time.Time
+	Reasons      []string
+}
+
+// Explain заполняет Reasons понятными пользователю причинами рекомендации
+func (r *Recommendation) Explain(event *Event, userCity string, at time.Time) {
+	reasons := make([]string, 0, 4)
+	if len(r.MatchedInterests) > 0 {
+		reasons = append(reasons, "matches: "+strings.Join(r.MatchedInterests, ", "))
+	}
+
+	switch {
+	case event.DistanceM != nil && *event.DistanceM <= nearbyReasonWithinM:
+		reasons = append(reasons, fmt.Sprintf("nearby: %.1f km", *event.DistanceM/1000))
+	case event.DistanceM == nil && userCity != "" && event.Address != nil && event.Address.City == userCity:
+		reasons = append(reasons, "in your city")
+	}
+
+	if r.NextStartsAt != nil && r.NextStartsAt.Sub(at) <= soonReasonWithin {
+		reasons = append(reasons, "starts soon")
+	}
+
+	if event.Rating != nil && *event.Rating >= ratingReasonFrom {
+		reasons = append(reasons, fmt.Sprintf("highly rated: %.1f", math.Round(float64(*event.Rating)*10)/10))
+	}
+
+	r.Reasons = reasons
+}
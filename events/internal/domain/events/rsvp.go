@@ -0,0 +1,78 @@
+package events
+
+import "time"
+
+type RsvpStatus string
+
+const (
+	RsvpGoing      RsvpStatus = "going"
+	RsvpInterested RsvpStatus = "interested"
+	RsvpNotGoing   RsvpStatus = "not_going"
+	// RsvpWaitlisted не выставляется пользователем: его получает "going", когда мест нет
+	RsvpWaitlisted RsvpStatus = "waitlisted"
+)
+
+// RsvpsCursorSort отличает курсор списка RSVP от остальных курсоров
+const RsvpsCursorSort = "rsvps"
+
+type Rsvp struct {
+	EventId   int64
+	UserId    int64
+	Status    RsvpStatus
+	UpdatedAt time.Time
+	Event     *Event
+}
+
+// RsvpParams - параметры списка RSVP пользователя, в нём только предстоящие события
+type RsvpParams struct {
+	Statuses []RsvpStatus
+
+	Limit  *int64
+	Cursor *string
+	After  *Cursor
+}
+
+// holdsSeat - статус занимает место на событии
+func (s RsvpStatus) holdsSeat() bool {
+	return s == RsvpGoing
+}
+
+// SeatsLeft - оставшиеся места при вместимости total и going_count going, nil - без ограничений
+func SeatsLeft(total *int32, going int32) *int32 {
+	if total == nil {
+		return nil
+	}
+	left := max(*total-going, 0)
+	return &left
+}
+
+// RsvpTransition - изменение счётчика going_count при смене статуса
+type RsvpTransition struct {
+	Status     RsvpStatus
+	GoingDelta int32
+	// FreedSeat - место освободилось, можно поднять кого-то из листа ожидания
+	FreedSeat bool
+}
+
+// NextRsvp решает, какой статус получит пользователь и как изменятся счётчики.
+// seats - оставшиеся места, nil - без ограничений.
+func NextRsvp(current *RsvpStatus, requested RsvpStatus, seats *int32) RsvpTransition {
+	wasGoing := current != nil && current.holdsSeat()
+
+	if requested == RsvpGoing {
+		if wasGoing {
+			return RsvpTransition{Status: RsvpGoing}
+		}
+		if seats != nil && *seats <= 0 {
+			return RsvpTransition{Status: RsvpWaitlisted}
+		}
+		return RsvpTransition{Status: RsvpGoing, GoingDelta: 1}
+	}
+
+	t := RsvpTransition{Status: requested}
+	if wasGoing {
+		t.GoingDelta = -1
+		t.FreedSeat = true
+	}
+	return t
+}
@@ -0,0 +1,108 @@
+package events
+
+import "testing"
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func rsvpPtr(s RsvpStatus) *RsvpStatus {
+	return &s
+}
+
+func TestNextRsvp(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   *RsvpStatus
+		requested RsvpStatus
+		seats     *int32
+		want      RsvpTransition
+	}{
+		{
+			name:      "going to not_going frees a seat",
+			current:   rsvpPtr(RsvpGoing),
+			requested: RsvpNotGoing,
+			seats:     int32Ptr(0),
+			want:      RsvpTransition{Status: RsvpNotGoing, GoingDelta: -1, FreedSeat: true},
+		},
+		{
+			name:      "waitlisted becomes going when seats open",
+			current:   rsvpPtr(RsvpWaitlisted),
+			requested: RsvpGoing,
+			seats:     int32Ptr(1),
+			want:      RsvpTransition{Status: RsvpGoing, GoingDelta: 1},
+		},
+		{
+			name:      "going without seats is waitlisted",
+			current:   nil,
+			requested: RsvpGoing,
+			seats:     int32Ptr(0),
+			want:      RsvpTransition{Status: RsvpWaitlisted},
+		},
+		{
+			name:      "going with unlimited seats",
+			current:   rsvpPtr(RsvpInterested),
+			requested: RsvpGoing,
+			seats:     nil,
+			want:      RsvpTransition{Status: RsvpGoing, GoingDelta: 1},
+		},
+		{
+			name:      "repeated going keeps the seat",
+			current:   rsvpPtr(RsvpGoing),
+			requested: RsvpGoing,
+			seats:     int32Ptr(0),
+			want:      RsvpTransition{Status: RsvpGoing},
+		},
+		{
+			name:      "waitlisted to not_going frees nothing",
+			current:   rsvpPtr(RsvpWaitlisted),
+			requested: RsvpNotGoing,
+			seats:     int32Ptr(0),
+			want:      RsvpTransition{Status: RsvpNotGoing},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextRsvp(tt.current, tt.requested, tt.seats); got != tt.want {
+				t.Errorf("NextRsvp() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeatsLeft(t *testing.T) {
+	if got := SeatsLeft(nil, 5); got != nil {
+		t.Errorf("SeatsLeft(nil) = %d, want nil", *got)
+	}
+	if got := SeatsLeft(int32Ptr(10), 3); got == nil || *got != 7 {
+		t.Errorf("SeatsLeft(10, 3) = %v, want 7", got)
+	}
+	// вместимость уменьшили ниже числа идущих
+	if got := SeatsLeft(int32Ptr(2), 3); got == nil || *got != 0 {
+		t.Errorf("SeatsLeft(2, 3) = %v, want 0", got)
+	}
+}
+
+// повторный импорт того же события не должен возвращать места, занятые через RSVP
+func TestReupsertKeepsTakenSeats(t *testing.T) {
+	payload := func() *Event {
+		return &Event{Title: "Квиз", Link: "https://example.com/quiz", SeatsTotal: int32Ptr(10)}
+	}
+
+	stored := payload()
+	transition := NextRsvp(nil, RsvpGoing, SeatsLeft(stored.SeatsTotal, stored.GoingCount))
+	stored.GoingCount += transition.GoingDelta
+
+	before := *SeatsLeft(stored.SeatsTotal, stored.GoingCount)
+	if before != 9 {
+		t.Fatalf("seats after rsvp = %d, want 9", before)
+	}
+
+	if changes := stored.ApplyChanges(payload()); len(changes) != 0 {
+		t.Errorf("ApplyChanges() = %+v, want no changes", changes)
+	}
+	if after := *SeatsLeft(stored.SeatsTotal, stored.GoingCount); after != before {
+		t.Errorf("seats after re-upsert = %d, want %d", after, before)
+	}
+}
@@ -1,10 +1,17 @@
 package events
 
 import (
+	"fmt"
 	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	"strings"
 	"time"
 )
 
+const (
+	DistanceSort  = "distance"
+	RelevanceSort = "relevance"
+)
+
 type Preset string
 
 const (
@@ -12,11 +19,16 @@ const (
 	PresetTomorrow Preset = "tomorrow"
 	PresetWeekends Preset = "weekends"
 	PresetWeekdays Preset = "weekdays"
+	PresetThisWeek Preset = "this_week"
+	PresetNextWeek Preset = "next_week"
 )
 
+// EventDate - фильтр по дате: конкретный день, пресет или диапазон дат From..To (включительно)
 type EventDate struct {
 	Date   *time.Time
 	Preset *Preset
+	From   *time.Time
+	To     *time.Time
 }
 
 func safeLocation(tz string) *time.Location {
@@ -34,51 +46,91 @@ func safeLocation(tz string) *time.Location {
 	return loc
 }
 
-func (e *EventDate) ToRange(tz string) (time.Time, time.Time) {
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// ToRange возвращает полуинтервал [start, end) в часовом поясе tz.
+// Нулевой end означает, что диапазон не ограничен сверху.
+func (e *EventDate) ToRange(tz string) (time.Time, time.Time, error) {
 	loc := safeLocation(tz)
 	now := time.Now().In(loc)
 
 	if e.Date != nil {
-		logger.Info(e.Date.String())
-		start := time.Date(e.Date.Year(), e.Date.Month(), e.Date.Day(), 0, 0, 0, 0, loc)
-		return start, start.Add(24 * time.Hour)
+		start := startOfDay(*e.Date, loc)
+		return start, start.AddDate(0, 0, 1), nil
+	}
+
+	if e.Preset != nil {
+		return e.Preset.toRange(now, loc)
 	}
 
-	switch *e.Preset {
+	if e.From == nil && e.To == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: empty date filter", ErrInvalidDateFilter)
+	}
+
+	var start, end time.Time
+	if e.From != nil {
+		start = startOfDay(*e.From, loc)
+	} else {
+		start = now
+	}
+	if e.To != nil {
+		end = startOfDay(*e.To, loc).AddDate(0, 0, 1)
+		if !end.After(start) {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: date_from is after date_to", ErrInvalidDateFilter)
+		}
+	}
+	return start, end, nil
+}
+
+func (p Preset) toRange(now time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	today := startOfDay(now, loc)
+
+	switch p {
 	case PresetToday:
-		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
-		return start, start.Add(24 * time.Hour)
+		return today, today.AddDate(0, 0, 1), nil
 
 	case PresetTomorrow:
-		start := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
-		return start, start.Add(24 * time.Hour)
+		start := today.AddDate(0, 0, 1)
+		return start, start.AddDate(0, 0, 1), nil
 
 	case PresetWeekends:
 		weekday := now.Weekday()
 		daysUntilSat := (time.Saturday - weekday + 7) % 7
-		sat := now.AddDate(0, 0, int(daysUntilSat))
-		start := time.Date(sat.Year(), sat.Month(), sat.Day(), 0, 0, 0, 0, loc)
-		return start, start.Add(48 * time.Hour)
+		start := today.AddDate(0, 0, int(daysUntilSat))
+		return start, start.AddDate(0, 0, 2), nil
 
 	case PresetWeekdays:
 		wd := now.Weekday()
 		if wd >= time.Monday && wd <= time.Friday {
-			start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
 			// конец — пятница
 			daysToFri := int(time.Friday - wd)
-			end := start.AddDate(0, 0, daysToFri+1) // exclusive
-			return start, end
+			return today, today.AddDate(0, 0, daysToFri+1), nil // exclusive
 		}
 
 		// иначе (Sat = 6, Sun = 0) — перенос на ближайший Mon
 		daysUntilMon := (int(time.Monday) - int(wd) + 7) % 7
-		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc).
-			AddDate(0, 0, daysUntilMon)
-		end := start.AddDate(0, 0, 5)
-		return start, end
+		start := today.AddDate(0, 0, daysUntilMon)
+		return start, start.AddDate(0, 0, 5), nil
+
+	case PresetThisWeek:
+		return today, nextMonday(today), nil
+
+	case PresetNextWeek:
+		start := nextMonday(today)
+		return start, start.AddDate(0, 0, 7), nil
 	}
 
-	panic("unknown preset")
+	return time.Time{}, time.Time{}, fmt.Errorf("%w: unknown preset %q", ErrInvalidDateFilter, p)
+}
+
+func nextMonday(day time.Time) time.Time {
+	days := (int(time.Monday) - int(day.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return day.AddDate(0, 0, days)
 }
 
 type SearchParams struct {
@@ -87,15 +139,58 @@ type SearchParams struct {
 	City     *string
 	District *string
 
-	MinPrice *int32
-	MaxPrice *int32
+	Lat      *float64
+	Lon      *float64
+	RadiusKm *float64
+
+	// MinPrice/MaxPrice - в минорных единицах валюты Currency
+	MinPrice *int64
+	MaxPrice *int64
+	Currency *string
 
 	EventDate *EventDate
+	// Timezone - часовой пояс пользователя, в нём считаются даты из EventDate
+	Timezone string
+	// StartsFrom/StartsTo - EventDate, разрешённый в диапазон [from, to)
+	StartsFrom *time.Time
+	StartsTo   *time.Time
 
 	EventType  *EventType
 	Categories []string
 
+	// Interests, UserCity и RecommendAt заполняются для сортировки recommended
+	Interests   []string
+	UserCity    string
+	RecommendAt *time.Time
+	// PopularAt - расчёт популярности, по которому идёт сортировка popular, nil - расчёта ещё не было
+	PopularAt *time.Time
+
 	Limit  *int64
-	LastID *int64
-	Offset *int64
+	Cursor *string
+	After  *Cursor
+}
+
+// Filters - параметры запроса без сортировки и пагинации, под них выдаётся курсор
+func (p *SearchParams) Filters() SearchParams {
+	f := *p
+	f.Sort, f.Limit, f.Cursor, f.After = nil, nil, nil, nil
+	return f
+}
+
+// ValidateSort проверяет, что для сортировки переданы нужные ей параметры
+func (p *SearchParams) ValidateSort() error {
+	if p.Sort == nil {
+		return nil
+	}
+	switch *p.Sort {
+	case DistanceSort:
+		if p.Lat == nil || p.Lon == nil {
+			return fmt.Errorf("%w: sort=distance requires lat and lon", ErrInvalidSort)
+		}
+	case RelevanceSort:
+		if p.Q == nil || strings.TrimSpace(*p.Q) == "" {
+			return fmt.Errorf("%w: sort=relevance requires q", ErrInvalidSort)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"sort"
+	"time"
+)
+
+// EventSession - один показ события: спектакль, квиз на конкретную дату, день выставки
+type EventSession struct {
+	Id             int64
+	StartsAt       time.Time
+	EndsAt         *time.Time
+	MinPrice       *int64
+	MaxPrice       *int64
+	Currency       *string
+	SeatsAvailable *int32
+}
+
+// EnsureSessions сортирует сеансы и выставляет StartsAt по первому из них.
+// Событие без сеансов получает один сеанс из собственных полей.
+func (e *Event) EnsureSessions() {
+	if len(e.Sessions) == 0 {
+		e.Sessions = []*EventSession{{
+			StartsAt:       e.StartsAt,
+			MinPrice:       e.MinPrice,
+			MaxPrice:       e.MaxPrice,
+			Currency:       e.Currency,
+			SeatsAvailable: e.SeatsTotal,
+		}}
+		return
+	}
+
+	// сеанс определяется временем начала, при повторах побеждает последний
+	byStart := make(map[time.Time]int, len(e.Sessions))
+	sessions := make([]*EventSession, 0, len(e.Sessions))
+	for _, s := range e.Sessions {
+		key := s.StartsAt.UTC()
+		if i, ok := byStart[key]; ok {
+			sessions[i] = s
+			continue
+		}
+		byStart[key] = len(sessions)
+		sessions = append(sessions, s)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].StartsAt.Before(sessions[j].StartsAt)
+	})
+	e.Sessions = sessions
+	e.StartsAt = sessions[0].StartsAt
+}
+
+// ValidateSessions проверяет, что каждый сеанс заканчивается позже начала
+// и что верхняя граница цены не меньше нижней
+func (e *Event) ValidateSessions() error {
+	if !validPriceRange(e.MinPrice, e.MaxPrice) {
+		return ErrInvalidPriceRange
+	}
+	for _, s := range e.Sessions {
+		if s.EndsAt != nil && !s.EndsAt.After(s.StartsAt) {
+			return ErrInvalidSession
+		}
+		if !validPriceRange(s.MinPrice, s.MaxPrice) {
+			return ErrInvalidPriceRange
+		}
+	}
+	return nil
+}
+
+func validPriceRange(minPrice, maxPrice *int64) bool {
+	return minPrice == nil || maxPrice == nil || *maxPrice >= *minPrice
+}
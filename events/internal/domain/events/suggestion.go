@@ -0,0 +1,18 @@
+package events
+
+type SuggestionType string
+
+const (
+	SuggestionTypeEvent    SuggestionType = "event"
+	SuggestionTypeVenue    SuggestionType = "venue"
+	SuggestionTypeCity     SuggestionType = "city"
+	SuggestionTypeCategory SuggestionType = "category"
+)
+
+type Suggestion struct {
+	Type    SuggestionType
+	Text    string
+	EventId *int64
+	Code    *string
+	Score   float32
+}
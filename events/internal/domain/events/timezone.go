@@ -0,0 +1,65 @@
+package events
+
+import "strings"
+
+const DefaultTimezone = "UTC"
+
+// города и страны хранятся на русском (парсер геокодит с accept-language=ru),
+// английские варианты - для профилей, заполненных вручную
+var cityTimezones = map[string]string{
+	"тбилиси": "Asia/Tbilisi", "tbilisi": "Asia/Tbilisi",
+	"батуми": "Asia/Tbilisi", "batumi": "Asia/Tbilisi",
+	"кутаиси": "Asia/Tbilisi", "kutaisi": "Asia/Tbilisi",
+	"ереван": "Asia/Yerevan", "yerevan": "Asia/Yerevan",
+	"гюмри": "Asia/Yerevan", "gyumri": "Asia/Yerevan",
+	"белград": "Europe/Belgrade", "belgrade": "Europe/Belgrade",
+	"нови-сад": "Europe/Belgrade", "нови сад": "Europe/Belgrade", "novi sad": "Europe/Belgrade",
+	"подгорица": "Europe/Podgorica", "podgorica": "Europe/Podgorica",
+	"будва": "Europe/Podgorica", "budva": "Europe/Podgorica",
+	"москва": "Europe/Moscow", "moscow": "Europe/Moscow",
+	"санкт-петербург": "Europe/Moscow", "saint petersburg": "Europe/Moscow",
+	"стамбул": "Europe/Istanbul", "istanbul": "Europe/Istanbul",
+	"анталья": "Europe/Istanbul", "antalya": "Europe/Istanbul",
+	"алматы": "Asia/Almaty", "almaty": "Asia/Almaty",
+	"астана": "Asia/Almaty", "astana": "Asia/Almaty",
+	"ташкент": "Asia/Tashkent", "tashkent": "Asia/Tashkent",
+	"бишкек": "Asia/Bishkek", "bishkek": "Asia/Bishkek",
+	"баку": "Asia/Baku", "baku": "Asia/Baku",
+	"минск": "Europe/Minsk", "minsk": "Europe/Minsk",
+	"кишинев": "Europe/Chisinau", "chisinau": "Europe/Chisinau",
+	"лимассол": "Asia/Nicosia", "limassol": "Asia/Nicosia",
+	"дубай": "Asia/Dubai", "dubai": "Asia/Dubai",
+}
+
+var countryTimezones = map[string]string{
+	"грузия": "Asia/Tbilisi", "georgia": "Asia/Tbilisi",
+	"армения": "Asia/Yerevan", "armenia": "Asia/Yerevan",
+	"сербия": "Europe/Belgrade", "serbia": "Europe/Belgrade",
+	"черногория": "Europe/Podgorica", "montenegro": "Europe/Podgorica",
+	"россия": "Europe/Moscow", "russia": "Europe/Moscow",
+	"турция": "Europe/Istanbul", "turkey": "Europe/Istanbul",
+	"казахстан": "Asia/Almaty", "kazakhstan": "Asia/Almaty",
+	"узбекистан": "Asia/Tashkent", "uzbekistan": "Asia/Tashkent",
+	"киргизия": "Asia/Bishkek", "кыргызстан": "Asia/Bishkek", "kyrgyzstan": "Asia/Bishkek",
+	"азербайджан": "Asia/Baku", "azerbaijan": "Asia/Baku",
+	"беларусь": "Europe/Minsk", "belarus": "Europe/Minsk",
+	"молдова": "Europe/Chisinau", "moldova": "Europe/Chisinau",
+	"кипр": "Asia/Nicosia", "cyprus": "Asia/Nicosia",
+	"оаэ": "Asia/Dubai", "uae": "Asia/Dubai",
+}
+
+func normalizePlace(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.ReplaceAll(s, "ё", "е")
+}
+
+// ResolveTimezone определяет часовой пояс пользователя по городу из профиля, иначе по стране
+func ResolveTimezone(country, city string) string {
+	if tz, ok := cityTimezones[normalizePlace(city)]; ok {
+		return tz
+	}
+	if tz, ok := countryTimezones[normalizePlace(country)]; ok {
+		return tz
+	}
+	return DefaultTimezone
+}
@@ -0,0 +1,7 @@
+package events
+
+// UpsertItem - событие из парсера вместе с кодами (или алиасами) его категорий
+type UpsertItem struct {
+	Event      *Event
+	Categories []string
+}
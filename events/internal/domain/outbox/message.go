@@ -0,0 +1,73 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	AggregateEvent  = "event"
+	AggregateReview = "review"
+
+	EventCreated  = "event.created"
+	EventUpdated  = "event.updated"
+	ReviewCreated = "review.created"
+	ReviewUpdated = "review.updated"
+	ReviewDeleted = "review.deleted"
+	RsvpPromoted  = "rsvp.promoted"
+)
+
+type Message struct {
+	Id            int64
+	AggregateType string
+	AggregateId   int64
+	EventType     string
+	// DedupKey уникален для каждого сообщения, потребители по нему отбрасывают повторы
+	DedupKey  string
+	Payload   []byte
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// Envelope - то, что уходит в kafka
+type Envelope struct {
+	Id          string          `json:"id"`
+	Type        string          `json:"type"`
+	AggregateId int64           `json:"aggregate_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+func NewMessage(aggregateType string, aggregateId int64, eventType, dedupKey string, data any) (*Message, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	payload, err := json.Marshal(Envelope{
+		Id:          dedupKey,
+		Type:        eventType,
+		AggregateId: aggregateId,
+		OccurredAt:  now,
+		Data:        raw,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		AggregateType: aggregateType,
+		AggregateId:   aggregateId,
+		EventType:     eventType,
+		DedupKey:      dedupKey,
+		Payload:       payload,
+		CreatedAt:     now,
+	}, nil
+}
+
+// Key - ключ сообщения в kafka: события одного агрегата попадают в одну партицию и не перемешиваются
+func (m *Message) Key() string {
+	return fmt.Sprintf("%s:%d", m.AggregateType, m.AggregateId)
+}
@@ -0,0 +1,29 @@
+package outbox
+
+import "time"
+
+type EventPayload struct {
+	Id         int64     `json:"id"`
+	Title      string    `json:"title"`
+	Link       string    `json:"link"`
+	StartsAt   time.Time `json:"starts_at"`
+	Country    string    `json:"country,omitempty"`
+	City       string    `json:"city,omitempty"`
+	ImageUrl   *string   `json:"image_url,omitempty"`
+	Categories []string  `json:"categories,omitempty"`
+	Changes    []string  `json:"changes,omitempty"`
+}
+
+type ReviewPayload struct {
+	Id        int64    `json:"id"`
+	EventId   int64    `json:"event_id"`
+	AuthorId  int64    `json:"author_id"`
+	Grade     int      `json:"grade"`
+	MediaKeys []string `json:"media_keys,omitempty"`
+}
+
+type RsvpPayload struct {
+	EventId int64  `json:"event_id"`
+	UserId  int64  `json:"user_id"`
+	Status  string `json:"status"`
+}
@@ -0,0 +1,88 @@
+package reminders
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusSending   Status = "sending"
+	StatusSent      Status = "sent"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+	StatusExpired   Status = "expired"
+)
+
+const (
+	MinHoursBefore = 1
+	MaxHoursBefore = 168
+)
+
+var (
+	ErrInvalidSettings  = errors.New("hours_before must be between 1 and 168")
+	ErrSettingsNotFound = errors.New("reminder settings not found")
+)
+
+// Settings - за сколько часов до начала сеанса напоминать пользователю
+type Settings struct {
+	UserId      int64
+	HoursBefore int32
+	Enabled     bool
+}
+
+func (s *Settings) Validate() error {
+	if s.HoursBefore < MinHoursBefore || s.HoursBefore > MaxHoursBefore {
+		return ErrInvalidSettings
+	}
+	return nil
+}
+
+// Reminder - напоминание, забранное на отправку, вместе с данными сеанса для текста
+type Reminder struct {
+	Id        int64
+	UserId    int64
+	EventId   int64
+	SessionId int64
+	RemindAt  time.Time
+	Attempts  int32
+
+	EventTitle string
+	EventLink  string
+	StartsAt   time.Time
+	VenueName  *string
+}
+
+// ScheduleParams - напоминания планируются на сеансы, начинающиеся в (Now, Now+MaxHoursBefore]
+type ScheduleParams struct {
+	Now time.Time
+	// DefaultHoursBefore - для пользователей без своих настроек
+	DefaultHoursBefore int32
+}
+
+func (p *ScheduleParams) Horizon() time.Time {
+	return p.Now.Add(MaxHoursBefore * time.Hour)
+}
+
+// Text - текст напоминания; время начала показывается в часовом поясе пользователя
+func (r *Reminder) Text(now time.Time, loc *time.Location) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Напоминание: «%s»\n", r.EventTitle))
+	sb.WriteString(fmt.Sprintf("Начало: %s (%s)\n", r.StartsAt.In(loc).Format("02.01 в 15:04"), timeLeft(r.StartsAt.Sub(now))))
+	if r.VenueName != nil && *r.VenueName != "" {
+		sb.WriteString(fmt.Sprintf("Место: %s\n", *r.VenueName))
+	}
+	sb.WriteString(r.EventLink)
+	return sb.String()
+}
+
+func timeLeft(d time.Duration) string {
+	if d < time.Hour {
+		return fmt.Sprintf("через %d мин.", max(int(d.Round(time.Minute)/time.Minute), 1))
+	}
+	return fmt.Sprintf("через %d ч.", int(d.Round(time.Hour)/time.Hour))
+}
@@ -3,7 +3,8 @@ package reviews
 import "errors"
 
 var (
-	ErrReviewNotFound = errors.New("event not found")
-	ErrReviewExists   = errors.New("event already exists")
-	ErrInvalid        = errors.New("invalid error")
+	ErrReviewNotFound  = errors.New("review not found")
+	ErrReviewExists    = errors.New("review already exists")
+	ErrInvalid         = errors.New("invalid error")
+	ErrReviewForbidden = errors.New("review belongs to another user")
 )
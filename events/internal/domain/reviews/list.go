@@ -0,0 +1,58 @@
+package reviews
+
+import "errors"
+
+const (
+	SortNewest    = "newest"
+	SortHighest   = "highest"
+	SortLowest    = "lowest"
+	SortHelpful   = "helpful"
+	SortWithMedia = "with_media"
+)
+
+const (
+	MinGrade = 0
+	MaxGrade = 10
+)
+
+var (
+	ErrInvalidSort   = errors.New("unknown review sort")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Cursor - позиция последнего отзыва страницы для keyset-пагинации
+type Cursor struct {
+	Sort string `json:"s"`
+	// Filters - отпечаток события и фильтров запроса, с другими курсор недействителен
+	Filters string  `json:"f,omitempty"`
+	Key     *string `json:"k,omitempty"`
+	Id      int64   `json:"id"`
+}
+
+type ListParams struct {
+	EventId int64
+	// Sort - одна из Sort*, по умолчанию SortNewest
+	Sort string
+	// WithPhotos - только отзывы с изображениями
+	WithPhotos bool
+
+	Limit  *int64
+	Cursor *string
+	After  *Cursor
+}
+
+// Filters - параметры запроса без сортировки и пагинации, под них выдаётся курсор
+func (p *ListParams) Filters() ListParams {
+	return ListParams{EventId: p.EventId, WithPhotos: p.WithPhotos}
+}
+
+func ValidSort(sort string) bool {
+	switch sort {
+	case SortNewest, SortHighest, SortLowest, SortHelpful, SortWithMedia:
+		return true
+	}
+	return false
+}
+
+// Histogram - число отзывов с каждой оценкой, индекс - оценка
+type Histogram [MaxGrade - MinGrade + 1]int64
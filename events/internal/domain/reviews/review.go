@@ -16,6 +16,8 @@ type MediaAttachment struct {
 }
 
 type Review struct {
+	Id            int64
+	EventId       int64
 	Grade         int
 	Advantages    string
 	Disadvantages string
@@ -23,4 +25,6 @@ type Review struct {
 	Media         []*MediaAttachment
 	AuthorId      int64
 	CreatedAt     time.Time
+	EditedAt      *time.Time
+	HelpfulCount  int32
 }
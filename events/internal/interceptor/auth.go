@@ -3,12 +3,22 @@ package interceptor
 import (
 	"context"
 	"errors"
+	desc "github.com/M1steryO/RelocatorEvents/events/pkg/events_v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"strconv"
 )
 
+// publicMethods доступны без пользователя: календарная лента защищена токеном из запроса
+var publicMethods = map[string]struct{}{
+	desc.Event_V1_GetCalendarFeedIcs_FullMethodName: {},
+}
+
 func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if _, ok := publicMethods[info.FullMethod]; ok {
+		return handler(ctx, req)
+	}
+
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, errors.New("metadata is not provided")
@@ -26,5 +36,9 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 	}
 
 	ctx = context.WithValue(ctx, "userId", userId)
+
+	if roleMetadata, ok := md["x-user-role"]; ok && len(roleMetadata) == 1 {
+		ctx = context.WithValue(ctx, "userRole", roleMetadata[0])
+	}
 	return handler(ctx, req)
 }
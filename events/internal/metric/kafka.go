@@ -0,0 +1,82 @@
+package metric
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	KafkaResultProcessed  = "processed"
+	KafkaResultDeadLetter = "dead_letter"
+	KafkaResultQuarantine = "quarantine"
+)
+
+type kafkaMetrics struct {
+	messagesCounter *prometheus.CounterVec
+	batchSize       prometheus.Histogram
+	batchDuration   prometheus.Histogram
+	inFlight        prometheus.Gauge
+	consumerLag     *prometheus.GaugeVec
+}
+
+func newKafkaMetrics() *kafkaMetrics {
+	return &kafkaMetrics{
+		messagesCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "kafka",
+				Name:      appName + "_messages_total",
+				Help:      "Number of consumed messages by result.",
+			},
+			[]string{"result"},
+		),
+		batchSize: promauto.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "kafka",
+			Name:      appName + "_batch_size",
+			Help:      "Number of messages in a processed batch.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 11),
+		}),
+		batchDuration: promauto.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "kafka",
+			Name:      appName + "_batch_duration_seconds",
+			Help:      "Time of processing a batch including the transaction commit.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+		}),
+		inFlight: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "kafka",
+			Name:      appName + "_in_flight_messages",
+			Help:      "Number of read messages whose offsets are not stored yet.",
+		}),
+		consumerLag: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "kafka",
+				Name:      appName + "_consumer_lag",
+				Help:      "Difference between the high watermark and the last read offset.",
+			},
+			[]string{"topic", "partition"},
+		),
+	}
+}
+
+func AddKafkaMessages(result string, n int) {
+	metrics.kafka.messagesCounter.WithLabelValues(result).Add(float64(n))
+}
+
+func ObserveKafkaBatch(size int, seconds float64) {
+	metrics.kafka.batchSize.Observe(float64(size))
+	metrics.kafka.batchDuration.Observe(seconds)
+}
+
+func AddKafkaInFlight(delta int) {
+	metrics.kafka.inFlight.Add(float64(delta))
+}
+
+func SetKafkaConsumerLag(topic string, partition int32, lag int64) {
+	metrics.kafka.consumerLag.WithLabelValues(topic, strconv.Itoa(int(partition))).Set(float64(lag))
+}
@@ -17,6 +17,8 @@ type Metrics struct {
 	requestCounter        prometheus.Counter
 	responseCounter       *prometheus.CounterVec
 	histogramResponseTime *prometheus.HistogramVec
+
+	kafka *kafkaMetrics
 }
 
 func Init(_ context.Context) error {
@@ -44,6 +46,8 @@ func Init(_ context.Context) error {
 				Help:      "Time of reciving response",
 				Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
 			}, []string{"status"}),
+
+		kafka: newKafkaMetrics(),
 	}
 	return nil
 
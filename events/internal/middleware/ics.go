@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+const icsSuffix = ".ics"
+
+// IcsMiddleware переводит /events/v1/{id}.ics в /events/v1/{id}/ics: шаблоны grpc-gateway
+// не поддерживают суффикс после переменной в сегменте пути
+func IcsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, icsSuffix) {
+			r.URL.Path = strings.TrimSuffix(r.URL.Path, icsSuffix) + "/ics"
+			r.URL.RawPath = ""
+		}
+		next.ServeHTTP(w, r)
+	})
+}
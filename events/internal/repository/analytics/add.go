@@ -0,0 +1,69 @@
+package analytics
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// AddViews пишет пачку просмотров, просмотры удалённых к этому моменту событий отбрасываются
+func (r *repo) AddViews(ctx context.Context, views []*domain.View) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	eventIds := make([]int64, 0, len(views))
+	userIds := make([]int64, 0, len(views))
+	sources := make([]string, 0, len(views))
+	viewedAt := make([]time.Time, 0, len(views))
+	for _, v := range views {
+		eventIds = append(eventIds, v.EventId)
+		userIds = append(userIds, v.UserId)
+		sources = append(sources, string(v.Source))
+		viewedAt = append(viewedAt, v.ViewedAt)
+	}
+
+	q := db.Query{
+		Title: "analytics_repository.AddViews",
+		Query: `insert into event_views (event_id, user_id, source, viewed_at)
+				select t.event_id, t.user_id, t.source, t.viewed_at
+				from unnest($1::bigint[], $2::bigint[], $3::text[], $4::timestamptz[]) as t(event_id, user_id, source, viewed_at)
+				join events e on e.id = t.event_id`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, eventIds, userIds, sources, viewedAt); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+// AddImpressions прибавляет показы к дневным счётчикам, пары (day, event_id) в пачке уникальны
+func (r *repo) AddImpressions(ctx context.Context, impressions []*domain.Impressions) error {
+	if len(impressions) == 0 {
+		return nil
+	}
+
+	days := make([]time.Time, 0, len(impressions))
+	eventIds := make([]int64, 0, len(impressions))
+	counts := make([]int64, 0, len(impressions))
+	for _, i := range impressions {
+		days = append(days, i.Day)
+		eventIds = append(eventIds, i.EventId)
+		counts = append(counts, i.Count)
+	}
+
+	q := db.Query{
+		Title: "analytics_repository.AddImpressions",
+		Query: `insert into event_impressions_daily (day, event_id, impressions)
+				select t.day, t.event_id, t.impressions
+				from unnest($1::date[], $2::bigint[], $3::bigint[]) as t(day, event_id, impressions)
+				join events e on e.id = t.event_id
+				on conflict (day, event_id) do update
+				set impressions = event_impressions_daily.impressions + excluded.impressions`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, days, eventIds, counts); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
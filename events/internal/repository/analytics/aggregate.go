@@ -0,0 +1,72 @@
+package analytics
+
+import (
+	"context"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// AggregateDaily пересчитывает view_daily_stats за дни [from, to) по всем измерениям,
+// вызывается в транзакции
+func (r *repo) AggregateDaily(ctx context.Context, from, to time.Time) (int64, error) {
+	// реплики агрегируют по очереди, иначе параллельные delete + insert упадут на первичном ключе
+	q := db.Query{
+		Title: "analytics_repository.AggregateDaily.Lock",
+		Query: `select pg_advisory_xact_lock(hashtext('view_daily_stats'))`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q); err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+
+	q = db.Query{
+		Title: "analytics_repository.AggregateDaily",
+		Query: `delete from view_daily_stats where day >= $1 and day < $2`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, from, to); err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+
+	q = db.Query{
+		Title: "analytics_repository.AggregateDaily",
+		Query: `with signals as (select (v.viewed_at at time zone 'UTC')::date as day, v.event_id, v.user_id,
+				                            'view' as kind, v.source, 0::bigint as impressions
+				                     from event_views v
+				                     where v.viewed_at >= $3 and v.viewed_at < $4
+				                     union all
+				                     select i.day, i.event_id, null, 'impression', null, i.impressions
+				                     from event_impressions_daily i
+				                     where i.day >= $1 and i.day < $2
+				                     union all
+				                     select (f.created_at at time zone 'UTC')::date, f.event_id, f.user_id, 'favorite', null, 0
+				                     from favorites f
+				                     where f.created_at >= $3 and f.created_at < $4),
+				     dims as (select s.*, 'event' as dimension, s.event_id::text as key
+				              from signals s
+				              union all
+				              select s.*, 'city', ea.city
+				              from signals s
+				              join events e on e.id = s.event_id
+				              join event_address ea on ea.id = e.address_id
+				              where ea.city != ''
+				              union all
+				              select s.*, 'category', c.code
+				              from signals s
+				              join event_categories ec on ec.event_id = s.event_id
+				              join categories c on c.id = ec.category_id)
+				insert into view_daily_stats (day, dimension, key, views, unique_viewers, list_views, impressions, favorites)
+				select day, dimension, key,
+				       count(*) filter (where kind = 'view'),
+				       count(distinct user_id) filter (where kind = 'view'),
+				       count(*) filter (where kind = 'view' and source = 'list'),
+				       sum(impressions),
+				       count(*) filter (where kind = 'favorite')
+				from dims
+				group by day, dimension, key`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, from, to, from, to)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,25 @@
+package converters
+
+import (
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/analytics/model"
+)
+
+func DailyStatsFromRepoToDomain(stats *model.DailyStats) *domain.DailyStats {
+	return &domain.DailyStats{
+		Day:           stats.Day,
+		Views:         stats.Views,
+		UniqueViewers: stats.UniqueViewers,
+		ListViews:     stats.ListViews,
+		Impressions:   stats.Impressions,
+		Favorites:     stats.Favorites,
+	}
+}
+
+func DailyStatsListFromRepoToDomain(stats []*model.DailyStats) []*domain.DailyStats {
+	result := make([]*domain.DailyStats, 0, len(stats))
+	for _, s := range stats {
+		result = append(result, DailyStatsFromRepoToDomain(s))
+	}
+	return result
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type DailyStats struct {
+	Day           time.Time `db:"day"`
+	Views         int64     `db:"views"`
+	UniqueViewers int64     `db:"unique_viewers"`
+	ListViews     int64     `db:"list_views"`
+	Impressions   int64     `db:"impressions"`
+	Favorites     int64     `db:"favorites"`
+}
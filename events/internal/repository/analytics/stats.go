@@ -0,0 +1,59 @@
+package analytics
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/analytics/converters"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/analytics/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+)
+
+func (r *repo) GetDailyStats(ctx context.Context, params *domain.StatsParams) ([]*domain.DailyStats, error) {
+	stats := make([]*model.DailyStats, 0)
+	q := db.Query{
+		Title: "analytics_repository.GetDailyStats",
+		Query: `select day, views, unique_viewers, list_views, impressions, favorites
+				from view_daily_stats
+				where dimension = $1 and key = $2 and day >= $3 and day < $4
+				order by day`,
+	}
+	err := r.db.DB().ScanAllContext(ctx, &stats, q, string(params.Dimension), params.Key, params.From, params.To)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.DailyStatsListFromRepoToDomain(stats), nil
+}
+
+// CountUniqueViewers считает уникальных зрителей за весь диапазон по сырым просмотрам:
+// сумма дневных unique_viewers учла бы одного пользователя несколько раз
+func (r *repo) CountUniqueViewers(ctx context.Context, params *domain.StatsParams) (int64, error) {
+	var condition string
+	switch params.Dimension {
+	case domain.DimensionEvent:
+		condition = "v.event_id = $1::text::bigint"
+	case domain.DimensionCity:
+		condition = `exists (select 1 from events e
+				                     join event_address ea on ea.id = e.address_id
+				                     where e.id = v.event_id and ea.city = $1)`
+	case domain.DimensionCategory:
+		condition = `exists (select 1 from event_categories ec
+				                     join categories c on c.id = ec.category_id
+				                     where ec.event_id = v.event_id and c.code = $1)`
+	default:
+		return 0, domain.ErrInvalidStatsParams
+	}
+
+	var count int64
+	q := db.Query{
+		Title: "analytics_repository.CountUniqueViewers",
+		Query: `select count(distinct v.user_id)
+				from event_views v
+				where v.viewed_at >= $2 and v.viewed_at < $3 and ` + condition,
+	}
+	err := r.db.DB().QueryRowContext(ctx, q, params.Key, params.From, params.To).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return count, nil
+}
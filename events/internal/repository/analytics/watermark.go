@@ -0,0 +1,32 @@
+package analytics
+
+import (
+	"context"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// GetAggregationWatermark возвращает первый ещё не закрытый день агрегации и блокирует его до конца транзакции
+func (r *repo) GetAggregationWatermark(ctx context.Context) (time.Time, error) {
+	q := db.Query{
+		Title: "analytics_repository.GetAggregationWatermark",
+		Query: `select day from view_stats_watermark for update`,
+	}
+	var day time.Time
+	if err := r.db.DB().QueryRowContext(ctx, q).Scan(&day); err != nil {
+		return time.Time{}, errors.Wrap(err, q.Title)
+	}
+	return day, nil
+}
+
+func (r *repo) SetAggregationWatermark(ctx context.Context, day time.Time) error {
+	q := db.Query{
+		Title: "analytics_repository.SetAggregationWatermark",
+		Query: `update view_stats_watermark set day = $1`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, day); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
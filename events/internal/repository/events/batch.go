@@ -0,0 +1,148 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/events/converters"
+	repoModel "github.com/M1steryO/RelocatorEvents/events/internal/repository/events/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+func (s *repo) GetByLinks(ctx context.Context, links []string) ([]*domain.Event, error) {
+	events := make([]*repoModel.Event, 0, len(links))
+	q := db.Query{
+		Title: "event_repository.GetByLinks",
+		Query: `select e.id, e.title, e.description, e.link, e.rating, 
+				   e.reviews_count, e.ratings_count, e.min_age, e.min_price, e.max_price, e.is_free, e.favorites_count, e.going_count,
+				   e.seats_total, e.type, e.starts_at, e.image_url, e.currency,
+				     ea.venue_name,ea.city,  ea.district,  ea.postal_code,  ea.country,  ea.full_address,  ea.latitude,  ea.longitude
+				from events e
+				left join event_address ea on e.address_id = ea.id
+				where e.link = any($1::text[])
+				order by e.id
+				for update of e
+				`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &events, q, links)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.EventsFromRepoToDomain(events), nil
+}
+
+// nextIds заранее выделяет id из sequence таблицы: порядок строк в returning
+// у многострочного insert не гарантирован, а id нужны в порядке входного среза
+func (s *repo) nextIds(ctx context.Context, table string, n int) ([]int64, error) {
+	ids := make([]int64, 0, n)
+	q := db.Query{
+		Title: "event_repository.nextIds",
+		Query: `select nextval(pg_get_serial_sequence($1, 'id'))
+				from generate_series(1, $2)`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &ids, q, table, n)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return ids, nil
+}
+
+func (s *repo) CreateEventAddresses(ctx context.Context, addresses []*domain.EventAddress) ([]int64, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	ids, err := s.nextIds(ctx, "event_address", len(addresses))
+	if err != nil {
+		return nil, err
+	}
+
+	const columns = 9
+	values := make([]string, 0, len(addresses))
+	args := make([]interface{}, 0, len(addresses)*columns)
+	idx := 1
+	for i, a := range addresses {
+		values = append(values, placeholders(idx, columns))
+		idx += columns
+		args = append(args, ids[i], a.VenueName, a.City, a.District, a.PostalCode,
+			a.Country, a.FullAddress, a.Latitude, a.Longitude)
+	}
+
+	q := db.Query{
+		Title: "event_repository.CreateEventAddresses",
+		Query: `insert into event_address (id, venue_name, city, district, postal_code, country, full_address, latitude, longitude)
+				values ` + strings.Join(values, ", "),
+	}
+	if _, err = s.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return ids, nil
+}
+
+func (s *repo) CreateBatch(ctx context.Context, events []*domain.Event, addressIds []int64) ([]int64, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	ids, err := s.nextIds(ctx, "events", len(events))
+	if err != nil {
+		return nil, err
+	}
+
+	const columns = 14
+	values := make([]string, 0, len(events))
+	args := make([]interface{}, 0, len(events)*columns)
+	idx := 1
+	for i, e := range events {
+		values = append(values, placeholders(idx, columns))
+		idx += columns
+		args = append(args, ids[i], e.Title, e.Description, e.Link, e.MinAge, e.SeatsTotal,
+			e.Type.String(), addressIds[i], e.MinPrice, e.StartsAt, e.ImageUrl, e.Currency, e.MaxPrice, e.IsFree)
+	}
+
+	q := db.Query{
+		Title: "event_repository.CreateBatch",
+		Query: `insert into events (id, title, description, link, min_age, seats_total, type, address_id, min_price, starts_at, image_url, currency,
+				                    max_price, is_free)
+				values ` + strings.Join(values, ", "),
+	}
+	if _, err = s.db.DB().ExecContext(ctx, q, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constraintErrorCode {
+			return nil, errors.Wrap(domain.ErrEventExists, q.Title)
+		}
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return ids, nil
+}
+
+// CreateEventCategories привязывает категории пачкой: eventIds[i] получает категорию codes[i]
+func (s *repo) CreateEventCategories(ctx context.Context, eventIds []int64, codes []string) error {
+	if len(eventIds) == 0 {
+		return nil
+	}
+
+	q := db.Query{
+		Title: "event_repository.CreateEventCategories",
+		Query: `insert into event_categories (event_id, category_id)
+				select t.event_id, c.id
+				from unnest($1::bigint[], $2::text[]) as t(event_id, code)
+				join categories c on c.code = t.code
+				on conflict (event_id, category_id) do nothing`,
+	}
+	if _, err := s.db.DB().ExecContext(ctx, q, eventIds, codes); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+func placeholders(from, n int) string {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = fmt.Sprintf("$%d", from+i)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
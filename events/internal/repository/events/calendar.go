@@ -0,0 +1,98 @@
+package events
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/events/converters"
+	repoModel "github.com/M1steryO/RelocatorEvents/events/internal/repository/events/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// EnsureCalendarFeed возвращает подписку пользователя, создавая её с token, если её ещё нет
+func (s *repo) EnsureCalendarFeed(ctx context.Context, userId int64, token string) (*domain.CalendarFeed, error) {
+	feed := &repoModel.CalendarFeed{}
+	q := db.Query{
+		Title: "event_repository.EnsureCalendarFeed",
+		Query: `insert into calendar_feeds (user_id, token)
+				values ($1, $2)
+				on conflict (user_id) do update
+				set token = calendar_feeds.token
+				returning user_id, token, created_at`,
+	}
+	err := s.db.DB().ScanOneContext(ctx, feed, q, userId, token)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.CalendarFeedFromRepoToDomain(feed), nil
+}
+
+// SetCalendarFeedToken создаёт подписку или заменяет её токен, старый токен перестаёт работать
+func (s *repo) SetCalendarFeedToken(ctx context.Context, userId int64, token string) (*domain.CalendarFeed, error) {
+	feed := &repoModel.CalendarFeed{}
+	q := db.Query{
+		Title: "event_repository.SetCalendarFeedToken",
+		Query: `insert into calendar_feeds (user_id, token)
+				values ($1, $2)
+				on conflict (user_id) do update
+				set token = excluded.token,
+				    created_at = now()
+				returning user_id, token, created_at`,
+	}
+	err := s.db.DB().ScanOneContext(ctx, feed, q, userId, token)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.CalendarFeedFromRepoToDomain(feed), nil
+}
+
+func (s *repo) GetCalendarFeedUser(ctx context.Context, token string) (int64, error) {
+	var userId int64
+	q := db.Query{
+		Title: "event_repository.GetCalendarFeedUser",
+		Query: `select user_id from calendar_feeds where token = $1`,
+	}
+	err := s.db.DB().QueryRowContext(ctx, q, token).Scan(&userId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errors.Wrap(domain.ErrCalendarFeedNotFound, q.Title)
+		}
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return userId, nil
+}
+
+// ListCalendarEvents возвращает избранные события пользователя и события с RSVP going/interested
+// вместе с сеансами, закончившимися не раньше from. События идут по ближайшему предстоящему сеансу,
+// он считается при чтении: events.starts_at устаревает, когда сеанс проходит.
+func (s *repo) ListCalendarEvents(ctx context.Context, userId int64, from time.Time) ([]*domain.Event, error) {
+	rows := make([]*repoModel.CalendarSession, 0)
+	q := db.Query{
+		Title: "event_repository.ListCalendarEvents",
+		Query: `select es.id as session_id, es.starts_at as session_starts_at, es.ends_at as session_ends_at,
+				   e.id, e.title, e.description, e.link, e.rating, 
+				   e.reviews_count, e.ratings_count, e.min_age, e.min_price, e.max_price, e.is_free, e.favorites_count, e.going_count,
+				   e.seats_total, e.type, e.starts_at, e.image_url, e.currency, e.updated_at, e.created_at,
+				     ea.venue_name,ea.city,  ea.district,  ea.postal_code,  ea.country,  ea.full_address,  ea.latitude,  ea.longitude
+				from events e
+				join event_sessions es on es.event_id = e.id
+				cross join lateral (
+					select min(next.starts_at) as next_starts_at
+					from event_sessions next
+					where next.event_id = e.id and next.starts_at > now()
+				) ns
+				left join event_address ea on e.address_id = ea.id
+				where coalesce(es.ends_at, es.starts_at) >= $2
+				  and (exists (select 1 from favorites f where f.user_id = $1 and f.event_id = e.id)
+				    or exists (select 1 from rsvps r
+				               where r.user_id = $1 and r.event_id = e.id and r.status in ('going', 'interested')))
+				order by ns.next_starts_at nulls last, e.id, es.starts_at`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &rows, q, userId, from)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.CalendarEventsFromRepoToDomain(rows), nil
+}
@@ -56,6 +56,20 @@ func toInt32FromNullInt32(num sql.NullInt32) *int32 {
 	return nil
 }
 
+func toInt64FromNullInt64(num sql.NullInt64) *int64 {
+	if num.Valid {
+		return &num.Int64
+	}
+	return nil
+}
+
+func toTimeFromNullTime(t sql.NullTime) *time.Time {
+	if t.Valid {
+		return &t.Time
+	}
+	return nil
+}
+
 func toFloat64FromNullFloat64(num sql.NullFloat64) *float64 {
 	if num.Valid {
 		return &num.Float64
@@ -79,16 +93,36 @@ func EventToDomainFromRepo(event *repoModel.Event) *domain.Event {
 		}(),
 		ReviewsCount:   toInt32FromNullInt32(event.ReviewsCount),
 		MinAge:         toInt32FromNullInt32(event.MinAge),
-		SeatsAvailable: toInt32FromNullInt32(event.SeatsAvailable),
-		MinPrice:       toInt32FromNullInt32(event.MinPrice),
+		SeatsTotal:     toInt32FromNullInt32(event.SeatsTotal),
+		SeatsAvailable: domain.SeatsLeft(toInt32FromNullInt32(event.SeatsTotal), event.GoingCount),
+		MinPrice:       toInt64FromNullInt64(event.MinPrice),
+		MaxPrice:       toInt64FromNullInt64(event.MaxPrice),
+		IsFree:         event.IsFree,
+		FavoritesCount: event.FavoritesCount,
+		GoingCount:     event.GoingCount,
 		Address:        eventAddressFromRepoToDomain(event.Address),
 		Currency:       toStringFromNullString(event.Currency),
 
 		Type:      eventTypeFromEnumToBasic(event.Type),
 		StartsAt:  event.StartsAt,
 		ImageUrl:  toStringFromNullString(event.ImageUrl),
+		Highlight: toStringFromNullString(event.Highlight),
+		DistanceM: toFloat64FromNullFloat64(event.DistanceM),
 		CreatedAt: event.CreatedAt,
 		UpdatedAt: timeToBasic(event.UpdatedAt),
+
+		Recommendation: recommendationFromRepoToDomain(event),
+	}
+}
+
+func recommendationFromRepoToDomain(event *repoModel.Event) *domain.Recommendation {
+	if !event.Score.Valid {
+		return nil
+	}
+	return &domain.Recommendation{
+		Score:            event.Score.Float64,
+		MatchedInterests: event.MatchedInterests,
+		NextStartsAt:     toTimeFromNullTime(event.NextStartsAt),
 	}
 }
 
@@ -114,11 +148,152 @@ func EventCategoriesFromRepoToDomain(cats []*repoModel.EventCategory) []*domain.
 	return newCats
 }
 
-func FiltersFromRepoToDomain(filters *repoModel.FiltersData) *domain.FiltersData {
+func FiltersFromRepoToDomain(filters *repoModel.FiltersData, currency string) *domain.FiltersData {
 	return &domain.FiltersData{
-		MinPrice:   toInt32FromNullInt32(filters.MinPrice),
-		MaxPrice:   toInt32FromNullInt32(filters.MaxPrice),
+		MinPrice:   toInt64FromNullInt64(filters.MinPrice),
+		MaxPrice:   toInt64FromNullInt64(filters.MaxPrice),
+		Currency:   currency,
 		Cities:     filters.Cities,
 		Categories: EventCategoriesFromRepoToDomain(filters.Categories),
 	}
 }
+
+func SuggestionsFromRepoToDomain(suggestions []*repoModel.Suggestion) []*domain.Suggestion {
+	result := make([]*domain.Suggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		if s == nil {
+			continue
+		}
+		result = append(result, &domain.Suggestion{
+			Type: domain.SuggestionType(s.Type),
+			Text: s.Text,
+			EventId: func() *int64 {
+				if s.EventId.Valid {
+					return &s.EventId.Int64
+				}
+				return nil
+			}(),
+			Code:  toStringFromNullString(s.Code),
+			Score: s.Score,
+		})
+	}
+	return result
+}
+
+func MapClustersFromRepoToDomain(clusters []*repoModel.MapCluster) []*domain.MapCluster {
+	result := make([]*domain.MapCluster, 0, len(clusters))
+	for _, c := range clusters {
+		if c == nil {
+			continue
+		}
+		result = append(result, &domain.MapCluster{
+			Count:     c.Count,
+			Latitude:  c.Latitude,
+			Longitude: c.Longitude,
+			SampleIds: c.SampleIds,
+		})
+	}
+	return result
+}
+
+func CategoryAliasesFromRepoToDomain(aliases []*repoModel.CategoryAlias) []*domain.CategoryAlias {
+	result := make([]*domain.CategoryAlias, 0, len(aliases))
+	for _, a := range aliases {
+		if a == nil {
+			continue
+		}
+		result = append(result, &domain.CategoryAlias{
+			Alias: a.Alias,
+			Category: &domain.EventCategory{
+				Title: a.Title,
+				Code:  a.Code,
+			},
+		})
+	}
+	return result
+}
+
+func EventSessionsFromRepoToDomain(sessions []*repoModel.EventSession) []*domain.EventSession {
+	result := make([]*domain.EventSession, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, &domain.EventSession{
+			Id:             s.Id,
+			StartsAt:       s.StartsAt,
+			EndsAt:         toTimeFromNullTime(s.EndsAt),
+			MinPrice:       toInt64FromNullInt64(s.MinPrice),
+			MaxPrice:       toInt64FromNullInt64(s.MaxPrice),
+			Currency:       toStringFromNullString(s.Currency),
+			SeatsAvailable: toInt32FromNullInt32(s.SeatsAvailable),
+		})
+	}
+	return result
+}
+
+func CurrencyRatesFromRepoToDomain(rates []*repoModel.CurrencyRate) []*domain.CurrencyRate {
+	result := make([]*domain.CurrencyRate, 0, len(rates))
+	for _, r := range rates {
+		if r == nil {
+			continue
+		}
+		result = append(result, &domain.CurrencyRate{
+			Currency:  r.Currency,
+			Rate:      r.Rate,
+			UpdatedAt: r.UpdatedAt,
+		})
+	}
+	return result
+}
+
+func RsvpsFromRepoToDomain(rsvps []*repoModel.Rsvp) []*domain.Rsvp {
+	result := make([]*domain.Rsvp, 0, len(rsvps))
+	for _, r := range rsvps {
+		event := EventToDomainFromRepo(r.Event)
+		result = append(result, &domain.Rsvp{
+			EventId:   event.Id,
+			Status:    domain.RsvpStatus(r.Status),
+			UpdatedAt: r.UpdatedAt,
+			Event:     event,
+		})
+	}
+	return result
+}
+
+func TrendingEventsFromRepoToDomain(events []*repoModel.TrendingEvent) []*domain.TrendingEvent {
+	result := make([]*domain.TrendingEvent, 0, len(events))
+	for _, e := range events {
+		result = append(result, &domain.TrendingEvent{
+			Event:     EventToDomainFromRepo(e.Event),
+			Score:     e.Score,
+			Views:     e.Views,
+			Favorites: e.Favorites,
+			Rsvps:     e.Rsvps,
+			Reviews:   e.Reviews,
+		})
+	}
+	return result
+}
+
+func CalendarFeedFromRepoToDomain(feed *repoModel.CalendarFeed) *domain.CalendarFeed {
+	return &domain.CalendarFeed{
+		Token:     feed.Token,
+		CreatedAt: feed.CreatedAt,
+	}
+}
+
+// CalendarEventsFromRepoToDomain собирает сеансы в события, строки отсортированы по событию
+func CalendarEventsFromRepoToDomain(rows []*repoModel.CalendarSession) []*domain.Event {
+	events := make([]*domain.Event, 0)
+	var current *domain.Event
+	for _, row := range rows {
+		if current == nil || current.Id != row.Event.Id {
+			current = EventToDomainFromRepo(row.Event)
+			events = append(events, current)
+		}
+		current.Sessions = append(current.Sessions, &domain.EventSession{
+			Id:       row.SessionId,
+			StartsAt: row.SessionStartsAt,
+			EndsAt:   toTimeFromNullTime(row.SessionEndsAt),
+		})
+	}
+	return events
+}
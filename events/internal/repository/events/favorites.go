@@ -0,0 +1,137 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/events/converters"
+	repoModel "github.com/M1steryO/RelocatorEvents/events/internal/repository/events/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"time"
+)
+
+const foreignKeyErrorCode = "23503"
+
+// AddFavorite добавляет событие в избранное и возвращает false, если оно там уже было
+func (s *repo) AddFavorite(ctx context.Context, userId, eventId int64) (bool, error) {
+	q := db.Query{
+		Title: "event_repository.AddFavorite",
+		Query: `with inserted as (
+					insert into favorites (user_id, event_id)
+					values ($1, $2)
+					on conflict (user_id, event_id) do nothing
+					returning event_id
+				)
+				update events
+				set favorites_count = favorites_count + 1
+				where id in (select event_id from inserted)`,
+	}
+	res, err := s.db.DB().ExecContext(ctx, q, userId, eventId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyErrorCode {
+			return false, domain.ErrEventNotFound
+		}
+		return false, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+// RemoveFavorite убирает событие из избранного и возвращает false, если его там не было
+func (s *repo) RemoveFavorite(ctx context.Context, userId, eventId int64) (bool, error) {
+	q := db.Query{
+		Title: "event_repository.RemoveFavorite",
+		Query: `with deleted as (
+					delete from favorites
+					where user_id = $1 and event_id = $2
+					returning event_id
+				)
+				update events
+				set favorites_count = greatest(favorites_count - 1, 0)
+				where id in (select event_id from deleted)`,
+	}
+	res, err := s.db.DB().ExecContext(ctx, q, userId, eventId)
+	if err != nil {
+		return false, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+// GetFavoriteEventIds возвращает те из eventIds, что есть в избранном пользователя
+func (s *repo) GetFavoriteEventIds(ctx context.Context, userId int64, eventIds []int64) ([]int64, error) {
+	ids := make([]int64, 0)
+	if len(eventIds) == 0 {
+		return ids, nil
+	}
+
+	q := db.Query{
+		Title: "event_repository.GetFavoriteEventIds",
+		Query: `select event_id
+				from favorites
+				where user_id = $1 and event_id = any($2)`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &ids, q, userId, eventIds)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return ids, nil
+}
+
+// ListFavorites отдаёт избранное пользователя, последние добавленные - первыми
+func (s *repo) ListFavorites(ctx context.Context, userId int64, params *domain.FavoritesParams) ([]*domain.Event, *domain.Cursor, error) {
+	events := make([]*repoModel.Event, 0)
+
+	conditions := "f.user_id = $1"
+	filters := []interface{}{userId}
+	idx := 2
+
+	if !params.IncludePast {
+		conditions += fmt.Sprintf(" and %s", sessionExists(fmt.Sprintf("es.starts_at > $%d", idx)))
+		filters = append(filters, time.Now())
+		idx++
+	}
+
+	if params.After != nil && params.After.Key != nil {
+		conditions += fmt.Sprintf(" and (f.created_at, f.event_id) < ($%d::timestamptz, $%d)", idx, idx+1)
+		filters = append(filters, *params.After.Key, params.After.Id)
+		idx += 2
+	}
+
+	q := db.Query{
+		Title: "event_repository.ListFavorites",
+		Query: fmt.Sprintf(`select e.id, e.title, e.description, e.link, e.rating, 
+				   e.reviews_count, e.ratings_count, e.min_age, e.min_price, e.max_price, e.is_free, e.favorites_count, e.going_count,
+				   e.seats_total, e.type, e.starts_at, e.image_url, e.currency,
+				     ea.venue_name,ea.city,  ea.district,  ea.postal_code,  ea.country,  ea.full_address,  ea.latitude,  ea.longitude,
+				   to_char(f.created_at at time zone 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"') as sort_key
+				from favorites f
+				join events e on e.id = f.event_id
+				left join event_address ea on e.address_id = ea.id
+				where %s
+				order by f.created_at desc, f.event_id desc
+				limit $%d`, conditions, idx),
+	}
+	// берём на одну запись больше, чтобы понять, есть ли следующая страница
+	limit := *params.Limit
+	filters = append(filters, limit+1)
+
+	err := s.db.DB().ScanAllContext(ctx, &events, q, filters...)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, q.Title)
+	}
+
+	var next *domain.Cursor
+	if int64(len(events)) > limit {
+		events = events[:limit]
+		last := events[len(events)-1]
+		next = &domain.Cursor{
+			Sort: domain.FavoritesCursorSort,
+			Key:  &last.SortKey.String,
+			Id:   last.Id,
+		}
+	}
+
+	return converters.EventsFromRepoToDomain(events), next, nil
+}
@@ -21,12 +21,24 @@ type Event struct {
 	ReviewsCount   sql.NullInt32   `db:"reviews_count"`
 	RatingsCount   sql.NullInt32   `db:"ratings_count"`
 	MinAge         sql.NullInt32   `db:"min_age"`
-	MinPrice       sql.NullInt32   `db:"min_price"`
+	MinPrice       sql.NullInt64   `db:"min_price"`
+	MaxPrice       sql.NullInt64   `db:"max_price"`
+	IsFree         bool            `db:"is_free"`
+	FavoritesCount int32           `db:"favorites_count"`
+	GoingCount     int32           `db:"going_count"`
 	Currency       sql.NullString  `db:"currency"`
-	SeatsAvailable sql.NullInt32   `db:"seats_available"`
+	SeatsTotal     sql.NullInt32   `db:"seats_total"`
 	Type           EventType       `db:"type"`
 	StartsAt       time.Time       `db:"starts_at"`
 	ImageUrl       sql.NullString  `db:"image_url"`
+	Highlight      sql.NullString  `db:"highlight"`
+	DistanceM      sql.NullFloat64 `db:"distance_m"`
+	SortKey        sql.NullString  `db:"sort_key"`
+
+	// заполняются при сортировке recommended
+	MatchedInterests []string        `db:"matched_interests"`
+	NextStartsAt     sql.NullTime    `db:"next_starts_at"`
+	Score            sql.NullFloat64 `db:"score"`
 
 	Address *EventAddress `db:""`
 
@@ -49,14 +61,88 @@ type EventAddress struct {
 	CreatedAt time.Time `db:"created_at"`
 }
 
+type EventSession struct {
+	Id             int64          `db:"id"`
+	StartsAt       time.Time      `db:"starts_at"`
+	EndsAt         sql.NullTime   `db:"ends_at"`
+	MinPrice       sql.NullInt64  `db:"min_price"`
+	MaxPrice       sql.NullInt64  `db:"max_price"`
+	Currency       sql.NullString `db:"currency"`
+	SeatsAvailable sql.NullInt32  `db:"seats_available"`
+}
+
+type Rsvp struct {
+	Status    string    `db:"rsvp_status"`
+	UpdatedAt time.Time `db:"rsvp_updated_at"`
+
+	Event *Event `db:""`
+}
+
+type TrendingEvent struct {
+	Score     float64 `db:"trending_score"`
+	Views     int32   `db:"trending_views"`
+	Favorites int32   `db:"trending_favorites"`
+	Rsvps     int32   `db:"trending_rsvps"`
+	Reviews   int32   `db:"trending_reviews"`
+
+	Event *Event `db:""`
+}
+
+type CalendarFeed struct {
+	UserId    int64     `db:"user_id"`
+	Token     string    `db:"token"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// CalendarSession - строка ленты календаря: сеанс вместе с его событием
+type CalendarSession struct {
+	SessionId       int64        `db:"session_id"`
+	SessionStartsAt time.Time    `db:"session_starts_at"`
+	SessionEndsAt   sql.NullTime `db:"session_ends_at"`
+
+	Event *Event `db:""`
+}
+
 type EventCategory struct {
 	Title string `db:"title"`
 	Code  string `db:"code"`
 }
 
+type CategoryAlias struct {
+	Alias string `db:"alias"`
+	Title string `db:"title"`
+	Code  string `db:"code"`
+}
+
+type CurrencyRate struct {
+	Currency  string    `db:"currency"`
+	Rate      float64   `db:"rate"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type ResolvedCategory struct {
+	Input string `db:"input"`
+	Code  string `db:"code"`
+}
+
 type FiltersData struct {
-	MinPrice   sql.NullInt32    `db:"min_price"`
-	MaxPrice   sql.NullInt32    `db:"max_price"`
+	MinPrice   sql.NullInt64    `db:"min_price"`
+	MaxPrice   sql.NullInt64    `db:"max_price"`
 	Cities     []string         `db:"cities"`
 	Categories []*EventCategory `db:"categories"`
 }
+
+type Suggestion struct {
+	Type    string         `db:"type"`
+	Text    string         `db:"text"`
+	EventId sql.NullInt64  `db:"event_id"`
+	Code    sql.NullString `db:"code"`
+	Score   float32        `db:"score"`
+}
+
+type MapCluster struct {
+	Count     int64   `db:"count"`
+	Latitude  float64 `db:"latitude"`
+	Longitude float64 `db:"longitude"`
+	SampleIds []int64 `db:"sample_ids"`
+}
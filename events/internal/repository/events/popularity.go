@@ -0,0 +1,118 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/events/converters"
+	repoModel "github.com/M1steryO/RelocatorEvents/events/internal/repository/events/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// RefreshPopularity пересчитывает event_popularity целиком, вызывается в транзакции:
+// до коммита читатели видят предыдущий расчёт
+func (s *repo) RefreshPopularity(ctx context.Context, params *domain.PopularityParams) (int64, error) {
+	// реплики пересчитывают таблицу по очереди, иначе параллельные delete + insert упадут на первичном ключе
+	q := db.Query{
+		Title: "event_repository.RefreshPopularity.Lock",
+		Query: `select pg_advisory_xact_lock(hashtext('event_popularity'))`,
+	}
+	if _, err := s.db.DB().ExecContext(ctx, q); err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+
+	q = db.Query{
+		Title: "event_repository.RefreshPopularity",
+		Query: `delete from event_popularity`,
+	}
+	if _, err := s.db.DB().ExecContext(ctx, q); err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+
+	q = db.Query{
+		Title: "event_repository.RefreshPopularity",
+		Query: `insert into event_popularity (event_id, score, week_score, views, favorites, rsvps, reviews, refreshed_at)
+				select s.event_id,
+				       sum(s.weight * power(0.5, extract(epoch from $1::timestamptz - s.at)::float8 / $2)),
+				       coalesce(sum(s.weight) filter (where s.at > $3), 0),
+				       count(*) filter (where s.kind = 'view' and s.at > $3),
+				       count(*) filter (where s.kind = 'favorite' and s.at > $3),
+				       count(*) filter (where s.kind = 'rsvp' and s.at > $3),
+				       count(*) filter (where s.kind = 'review' and s.at > $3),
+				       $1
+				from (select event_id, viewed_at as at, 'view' as kind, $5::float8 as weight
+				      from event_views
+				      where viewed_at > $4
+				      union all
+				      select event_id, created_at, 'favorite', $6::float8
+				      from favorites
+				      where created_at > $4
+				      union all
+				      select event_id, updated_at, 'rsvp', case when status = 'going' then $7::float8 else $8::float8 end
+				      from rsvps
+				      where status in ('going', 'interested') and updated_at > $4
+				      union all
+				      select event_id, created_at, 'review', $9::float8
+				      from reviews
+				      where created_at > $4) s
+				group by s.event_id`,
+	}
+	res, err := s.db.DB().ExecContext(ctx, q,
+		params.At,
+		params.HalfLife.Seconds(),
+		params.At.Add(-domain.TrendingWindow),
+		params.At.Add(-domain.PopularityWindow),
+		domain.PopularityViewWeight,
+		domain.PopularityFavoriteWeight,
+		domain.PopularityGoingWeight,
+		domain.PopularityInterestedWeight,
+		domain.PopularityReviewWeight,
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected(), nil
+}
+
+// GetPopularityRefreshedAt возвращает момент последнего пересчёта популярности, nil - пересчёта ещё не было
+func (s *repo) GetPopularityRefreshedAt(ctx context.Context) (*time.Time, error) {
+	var refreshedAt sql.NullTime
+	q := db.Query{
+		Title: "event_repository.GetPopularityRefreshedAt",
+		Query: `select max(refreshed_at) from event_popularity`,
+	}
+	if err := s.db.DB().QueryRowContext(ctx, q).Scan(&refreshedAt); err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	if !refreshedAt.Valid {
+		return nil, nil
+	}
+	return &refreshedAt.Time, nil
+}
+
+// ListTrending возвращает предстоящие события города с наибольшим week_score
+func (s *repo) ListTrending(ctx context.Context, params *domain.TrendingParams) ([]*domain.TrendingEvent, error) {
+	events := make([]*repoModel.TrendingEvent, 0)
+	q := db.Query{
+		Title: "event_repository.ListTrending",
+		Query: `select ep.week_score as trending_score, ep.views as trending_views, ep.favorites as trending_favorites,
+				   ep.rsvps as trending_rsvps, ep.reviews as trending_reviews,
+				   e.id, e.title, e.description, e.link, e.rating, 
+				   e.reviews_count, e.ratings_count, e.min_age, e.min_price, e.max_price, e.is_free, e.favorites_count, e.going_count,
+				   e.seats_total, e.type, e.starts_at, e.image_url, e.currency,
+				     ea.venue_name,ea.city,  ea.district,  ea.postal_code,  ea.country,  ea.full_address,  ea.latitude,  ea.longitude
+				from event_popularity ep
+				join events e on e.id = ep.event_id
+				left join event_address ea on e.address_id = ea.id
+				where ea.city = $1 and ep.week_score > 0 and ` + sessionExists("es.starts_at > $2") + `
+				order by ep.week_score desc, e.id
+				limit $3`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &events, q, params.City, time.Now(), *params.Limit)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.TrendingEventsFromRepoToDomain(events), nil
+}
@@ -8,10 +8,9 @@ import (
 	"github.com/M1steryO/RelocatorEvents/events/internal/repository/events/converters"
 	repoModel "github.com/M1steryO/RelocatorEvents/events/internal/repository/events/model"
 	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
-	"strings"
-	"time"
 )
 
 const constraintErrorCode = "23505"
@@ -31,8 +30,8 @@ func (s *repo) Get(ctx context.Context, id int64) (*domain.Event, error) {
 	q := db.Query{
 		Title: "event_repository.Get",
 		Query: `select e.id, e.title, e.description, e.link, e.rating, 
-				   e.reviews_count, e.ratings_count, e.min_age, e.min_price,
-				   e.seats_available, e.type, e.starts_at, e.image_url, e.currency,
+				   e.reviews_count, e.ratings_count, e.min_age, e.min_price, e.max_price, e.is_free, e.favorites_count, e.going_count,
+				   e.seats_total, e.type, e.starts_at, e.image_url, e.currency,
 				     ea.venue_name,ea.city,  ea.district,  ea.postal_code,  ea.country,  ea.full_address,  ea.latitude,  ea.longitude
 				from events e
 				left join event_address ea on e.address_id = ea.id
@@ -49,18 +48,83 @@ func (s *repo) Get(ctx context.Context, id int64) (*domain.Event, error) {
 	return converters.EventToDomainFromRepo(event), nil
 }
 
-func (s *repo) UpdateRating(ctx context.Context, eventId int64, grade int) error {
+func (s *repo) GetByLink(ctx context.Context, link string) (*domain.Event, error) {
+	event := &repoModel.Event{}
+	q := db.Query{
+		Title: "event_repository.GetByLink",
+		Query: `select e.id, e.title, e.description, e.link, e.rating, 
+				   e.reviews_count, e.ratings_count, e.min_age, e.min_price, e.max_price, e.is_free, e.favorites_count, e.going_count,
+				   e.seats_total, e.type, e.starts_at, e.image_url, e.currency,
+				     ea.venue_name,ea.city,  ea.district,  ea.postal_code,  ea.country,  ea.full_address,  ea.latitude,  ea.longitude
+				from events e
+				left join event_address ea on e.address_id = ea.id
+				where e.link = $1
+				for update of e
+				`,
+	}
+	err := s.db.DB().ScanOneContext(ctx, event, q, link)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.EventToDomainFromRepo(event), nil
+}
+
+func (s *repo) GetEventCategories(ctx context.Context, eventId int64) ([]*domain.EventCategory, error) {
+	categories := make([]*repoModel.EventCategory, 0)
+	q := db.Query{
+		Title: "event_repository.GetEventCategories",
+		Query: `select c.title, c.code
+				from event_categories ec
+				join categories c on ec.category_id = c.id
+				where ec.event_id = $1
+				order by c.id`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &categories, q, eventId)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.EventCategoriesFromRepoToDomain(categories), nil
+}
+
+// IncrementVersion увеличивает версию события при изменении, версия входит в ключ дедупликации event.updated
+func (s *repo) IncrementVersion(ctx context.Context, id int64) (int64, error) {
+	q := db.Query{
+		Title: "event_repository.IncrementVersion",
+		Query: `update events
+				set version = version + 1, updated_at = now()
+				where id = $1
+				returning version`,
+	}
+	var version int64
+	err := s.db.DB().QueryRowContext(ctx, q, id).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrEventNotFound
+		}
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return version, nil
+}
+
+// UpdateRating сдвигает число отзывов и сумму оценок события и пересчитывает средний рейтинг:
+// новый отзыв - (1, grade), правка оценки - (0, new-old), удаление - (-1, -grade)
+func (s *repo) UpdateRating(ctx context.Context, eventId int64, countDelta, gradeDelta int) error {
 
 	q := db.Query{
 		Title: "event_repository.UpdateRating",
 		Query: `update events 
-				set reviews_count = reviews_count + 1,
-				rating_sum    = rating_sum + $1,
-  				rating = round((rating_sum + $1)::numeric / (reviews_count + 1), 2)
-				where id = $2`,
+				set reviews_count = reviews_count + $1,
+				rating_sum    = rating_sum + $2,
+  				rating = case when reviews_count + $1 > 0
+  				              then round((rating_sum + $2)::numeric / (reviews_count + $1), 2)
+  				              else 0 end
+				where id = $3`,
 	}
 
-	res, err := s.db.DB().ExecContext(ctx, q, grade, eventId)
+	res, err := s.db.DB().ExecContext(ctx, q, countDelta, gradeDelta, eventId)
 	if err != nil {
 		return errors.Wrap(err, q.Title)
 	}
@@ -74,21 +138,73 @@ func (s *repo) UpdateRating(ctx context.Context, eventId int64, grade int) error
 func (s *repo) Create(ctx context.Context, event *domain.Event, addressId int64) (int64, error) {
 	q := db.Query{
 		Title: "event_repository.Create",
-		Query: `insert into events (title, description, link, min_age, seats_available, type, address_id, min_price, starts_at, image_url, currency)
-				values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) returning id`,
+		Query: `insert into events (title, description, link, min_age, seats_total, type, address_id, min_price, starts_at, image_url, currency,
+				                    max_price, is_free)
+				values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) returning id`,
 	}
 	var id int64
 	err := s.db.DB().QueryRowContext(ctx, q, event.Title, event.Description,
-		event.Link, event.MinAge, event.SeatsAvailable,
+		event.Link, event.MinAge, event.SeatsTotal,
 		event.Type.String(), addressId,
-		event.MinPrice, event.StartsAt, event.ImageUrl, event.Currency).Scan(&id)
+		event.MinPrice, event.StartsAt, event.ImageUrl, event.Currency,
+		event.MaxPrice, event.IsFree).Scan(&id)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constraintErrorCode {
+			return 0, errors.Wrap(domain.ErrEventExists, q.Title)
+		}
 		return 0, errors.Wrap(err, q.Title)
 	}
 
 	return id, nil
 }
 
+func (s *repo) Update(ctx context.Context, event *domain.Event) (int64, error) {
+	q := db.Query{
+		Title: "event_repository.Update",
+		Query: `update events
+				set title = $2, description = $3, link = $4, min_age = $5, seats_total = $6,
+				    type = $7, min_price = $8, image_url = $9, currency = $10,
+				    max_price = $11, is_free = $12, updated_at = now()
+				where id = $1
+				returning coalesce(address_id, 0)`,
+	}
+	var addressId int64
+	err := s.db.DB().QueryRowContext(ctx, q, event.Id, event.Title, event.Description,
+		event.Link, event.MinAge, event.SeatsTotal,
+		event.Type.String(), event.MinPrice,
+		event.ImageUrl, event.Currency, event.MaxPrice, event.IsFree).Scan(&addressId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrEventNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constraintErrorCode {
+			return 0, errors.Wrap(domain.ErrEventExists, q.Title)
+		}
+		return 0, errors.Wrap(err, q.Title)
+	}
+
+	return addressId, nil
+}
+
+func (s *repo) Delete(ctx context.Context, id int64) (int64, error) {
+	q := db.Query{
+		Title: "event_repository.Delete",
+		Query: `delete from events where id = $1 returning coalesce(address_id, 0)`,
+	}
+	var addressId int64
+	err := s.db.DB().QueryRowContext(ctx, q, id).Scan(&addressId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrEventNotFound
+		}
+		return 0, errors.Wrap(err, q.Title)
+	}
+
+	return addressId, nil
+}
+
 func (s *repo) CreateEventAddress(ctx context.Context, event *domain.EventAddress) (int64, error) {
 	q := db.Query{
 		Title: "event_repository.CreateAddress",
@@ -107,7 +223,53 @@ func (s *repo) CreateEventAddress(ctx context.Context, event *domain.EventAddres
 
 	return id, nil
 }
-func (s *repo) CreateEventCategory(ctx context.Context, eventId int64, categoryCode string) error {
+
+func (s *repo) UpdateEventAddress(ctx context.Context, addressId int64, address *domain.EventAddress) error {
+	q := db.Query{
+		Title: "event_repository.UpdateEventAddress",
+		Query: `update event_address
+				set venue_name = $2, city = $3, district = $4, postal_code = $5,
+				    country = $6, full_address = $7, latitude = $8, longitude = $9
+				where id = $1`,
+	}
+	_, err := s.db.DB().ExecContext(ctx, q, addressId, address.VenueName,
+		address.City, address.District, address.PostalCode,
+		address.Country, address.FullAddress,
+		address.Latitude, address.Longitude)
+	if err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+
+	return nil
+}
+
+func (s *repo) SetEventAddress(ctx context.Context, eventId, addressId int64) error {
+	q := db.Query{
+		Title: "event_repository.SetEventAddress",
+		Query: `update events set address_id = $2 where id = $1`,
+	}
+	_, err := s.db.DB().ExecContext(ctx, q, eventId, addressId)
+	if err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+
+	return nil
+}
+
+func (s *repo) DeleteEventAddress(ctx context.Context, addressId int64) error {
+	q := db.Query{
+		Title: "event_repository.DeleteEventAddress",
+		Query: `delete from event_address where id = $1`,
+	}
+	_, err := s.db.DB().ExecContext(ctx, q, addressId)
+	if err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+
+	return nil
+}
+
+func (s *repo) CreateEventCategory(ctx context.Context, eventId int64, categoryCode string) (bool, error) {
 	q := db.Query{
 		Title: "event_repository.CreateEventCategory",
 		Query: `INSERT INTO event_categories (event_id, category_id)
@@ -119,165 +281,335 @@ func (s *repo) CreateEventCategory(ctx context.Context, eventId int64, categoryC
 
 	rows, err := s.db.DB().ExecContext(ctx, q, eventId, categoryCode)
 	if err != nil {
-		return errors.Wrap(err, q.Title)
+		return false, errors.Wrap(err, q.Title)
 	}
 
 	if rows.RowsAffected() == 0 {
 		logger.Warn("event category not found")
+		return false, nil
+	}
+	return true, nil
+}
+
+func (s *repo) DeleteEventCategories(ctx context.Context, eventId int64) error {
+	q := db.Query{
+		Title: "event_repository.DeleteEventCategories",
+		Query: `delete from event_categories where event_id = $1`,
+	}
+	_, err := s.db.DB().ExecContext(ctx, q, eventId)
+	if err != nil {
+		return errors.Wrap(err, q.Title)
 	}
+
 	return nil
 }
-func (s *repo) GetList(ctx context.Context, params *domain.SearchParams, country string) ([]*domain.Event, error) {
+func (s *repo) GetList(ctx context.Context, params *domain.SearchParams, country string) ([]*domain.Event, *domain.Cursor, error) {
 	events := make([]*repoModel.Event, 0)
 
-	var filters []interface{}
-	var conditions []string
+	sq := buildSearchQuery(params, country)
+	sort := buildListSort(params, sq)
+	filters := sq.filters
+	idx := sq.idx
+
+	if sort.key != "" {
+		sq.extraColumns += `,
+				   (` + sort.key + `)::text as sort_key`
+	}
+
+	if params != nil && params.After != nil {
+		condition, args := sort.after(params.After, idx)
+		sq.conditions = append(sq.conditions, condition)
+		filters = append(filters, args...)
+		idx += len(args)
+	}
 
 	q := db.Query{
 		Title: "event_repository.GetList",
-		Query: `select e.id, e.title, e.description, e.link, e.rating, 
-				   e.reviews_count, e.ratings_count, e.min_age, e.min_price,
-				   e.seats_available, e.type, e.starts_at, e.image_url, e.currency
+		Query: fmt.Sprintf(`select e.id, e.title, e.description, e.link, e.rating, 
+				   e.reviews_count, e.ratings_count, e.min_age, e.min_price, e.max_price, e.is_free, e.favorites_count, e.going_count,
+				   e.seats_total, e.type, e.starts_at, e.image_url, e.currency%s
 				from events e
-				left join event_address ea on e.address_id = ea.id`,
+				left join event_address ea on e.address_id = ea.id
+				left join event_popularity ep on ep.event_id = e.id`, sq.extraColumns),
+	}
+	q.Query += sq.where() + sort.orderBy()
+
+	var limit int64
+	if params != nil && params.Limit != nil {
+		limit = *params.Limit
+		// берём на одну запись больше, чтобы понять, есть ли следующая страница
+		q.Query += fmt.Sprintf(" LIMIT $%d", idx)
+		filters = append(filters, limit+1)
 	}
-	idx := 1
 
-	if params != nil {
-		if params.Categories != nil {
-			conditions = append(conditions, fmt.Sprintf(`
-					EXISTS (
-					SELECT 1 FROM event_categories ec
-					JOIN categories c ON ec.category_id = c.id
-					WHERE ec.event_id = e.id AND c.code = ANY($%d)
-					)`, idx))
-			filters = append(filters, params.Categories)
-			idx += 1
+	err := s.db.DB().ScanAllContext(ctx, &events, q, filters...)
+
+	if err != nil {
+		return nil, nil, err
+	}
 
+	var next *domain.Cursor
+	if limit > 0 && int64(len(events)) > limit {
+		events = events[:limit]
+		last := events[len(events)-1]
+		next = &domain.Cursor{
+			Sort: sort.name,
+			Key: func() *string {
+				if last.SortKey.Valid {
+					return &last.SortKey.String
+				}
+				return nil
+			}(),
+			Id: last.Id,
 		}
-		if params.Q != nil {
-			conditions = append(conditions, fmt.Sprintf("e.title ILIKE '%%' || $%d || '%%'", idx))
-			filters = append(filters, *params.Q)
-			idx++
+		switch sort.name {
+		case domain.RecommendedSort:
+			next.At = params.RecommendAt
+		case domain.PopularSort:
+			next.At = params.PopularAt
 		}
+	}
 
-		if params.City != nil {
-			conditions = append(conditions, fmt.Sprintf("ea.city = $%d", idx))
-			filters = append(filters, *params.City)
-			idx++
-		}
+	return converters.EventsFromRepoToDomain(events), next, nil
+}
 
-		if params.District != nil {
-			conditions = append(conditions, fmt.Sprintf("ea.district = $%d", idx))
-			filters = append(filters, *params.District)
-			idx++
-		}
+// GetFiltersData считает диапазон цен в валюте currency, события без курса в него не входят
+func (s *repo) GetFiltersData(ctx context.Context, userCountry string, currency string) (*domain.FiltersData, error) {
+	data := &repoModel.FiltersData{}
+	q := db.Query{
+		Title: "event_repository.GetFiltersData",
+		Query: `select min(case when e.is_free then 0 else convert_price(e.min_price, e.currency, $2) end) as min_price,
+       			max(case when e.is_free then 0 else convert_price(coalesce(e.max_price, e.min_price), e.currency, $2) end) as max_price,
+       			COALESCE(array_agg(distinct ea.city), '{}') as cities,
+       			COALESCE(jsonb_agg(
+                        DISTINCT jsonb_build_object(
+                       'code', ca.code,
+                       'title', ca.title)) FILTER (WHERE ca.id IS NOT NULL), '[]'::jsonb) AS categories
+				from events e
+				left join event_address ea on e.address_id = ea.id
+				left join event_categories ec on e.id = ec.event_id
+				left join categories ca on ec.category_id = ca.id
+				where ea.country = $1 and ea.city != '' and exists (select 1 from event_sessions es where es.event_id = e.id and es.starts_at > now())
+				`,
+	}
+	err := s.db.DB().ScanOneContext(ctx, data, q, userCountry, currency)
+	if err != nil {
+		return nil, err
+	}
+	return converters.FiltersFromRepoToDomain(data, currency), nil
+}
 
-		if params.MinPrice != nil {
-			conditions = append(conditions, fmt.Sprintf("e.min_price >= $%d", idx))
-			filters = append(filters, *params.MinPrice)
-			idx++
-		}
+func (s *repo) Suggest(ctx context.Context, query string, country string, limit int64) ([]*domain.Suggestion, error) {
+	suggestions := make([]*repoModel.Suggestion, 0)
 
-		if params.MaxPrice != nil {
-			conditions = append(conditions, fmt.Sprintf("e.min_price <= $%d", idx))
-			filters = append(filters, *params.MaxPrice)
-			idx++
-		}
+	threshold := db.Query{
+		Title: "event_repository.SuggestThreshold",
+		Query: `set local pg_trgm.word_similarity_threshold = 0.3`,
+	}
+	_, err := s.db.DB().ExecContext(ctx, threshold)
+	if err != nil {
+		return nil, errors.Wrap(err, threshold.Title)
+	}
 
-		const TZ = "Europe/Moscow" // TODO getting timezone from client
-		if params.EventDate != nil {
-			startDate, endDate := params.EventDate.ToRange(TZ)
-			conditions = append(conditions, fmt.Sprintf("e.starts_at between $%d and $%d", idx, idx+1))
-			filters = append(filters, startDate, endDate)
-			idx += 2
-		} else {
-			now := time.Now()
-			conditions = append(conditions, fmt.Sprintf("e.starts_at > $%d", idx))
-			filters = append(filters, now)
-			idx++
-		}
+	q := db.Query{
+		Title: "event_repository.Suggest",
+		Query: `select type, text, event_id, code, score
+				from (
+					select 'event' as type, e.title as text, e.id as event_id, null as code,
+					       word_similarity($1, e.title) as score
+					from events e
+					join event_address ea on e.address_id = ea.id
+					where ea.country = $2 and exists (select 1 from event_sessions es where es.event_id = e.id and es.starts_at > now()) and $1 <% e.title
+
+					union all
+
+					select 'venue', ea.venue_name, null, null, max(word_similarity($1, ea.venue_name))
+					from event_address ea
+					join events e on e.address_id = ea.id
+					where ea.country = $2 and exists (select 1 from event_sessions es where es.event_id = e.id and es.starts_at > now()) and $1 <% ea.venue_name
+					group by ea.venue_name
+
+					union all
+
+					select 'city', ea.city, null, null, max(word_similarity($1, ea.city))
+					from event_address ea
+					where ea.country = $2 and ea.city != '' and $1 <% ea.city
+					group by ea.city
+
+					union all
+
+					select 'category', c.title, null, c.code, word_similarity($1, c.title)
+					from categories c
+					where $1 <% c.title
+				) s
+				order by score desc, text
+				limit $3`,
+	}
+	err = s.db.DB().ScanAllContext(ctx, &suggestions, q, query, country, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
 
-		if params.EventType != nil {
-			conditions = append(conditions, fmt.Sprintf("e.type = $%d", idx))
-			filters = append(filters, params.EventType.String())
-			idx++
-		}
+	return converters.SuggestionsFromRepoToDomain(suggestions), nil
+}
 
-		if params.LastID != nil {
-			conditions = append(conditions, fmt.Sprintf("e.id > $%d", idx))
-			filters = append(filters, *params.LastID)
-			idx++
-		}
+func (s *repo) GetMapClusters(ctx context.Context, params *domain.SearchParams, country string, viewport *domain.MapViewport) ([]*domain.MapCluster, error) {
+	clusters := make([]*repoModel.MapCluster, 0)
 
-		if country != "" {
-			conditions = append(conditions, fmt.Sprintf("ea.country = $%d", idx))
-			filters = append(filters, country)
-			idx++
-		}
+	sq := buildSearchQuery(params, country)
+	filters := sq.filters
+	idx := sq.idx
 
-		if len(conditions) > 0 {
-			q.Query += " WHERE " + strings.Join(conditions, " AND ")
-		}
-		if params.Sort != nil {
-			switch *params.Sort {
-			case "popular":
-				q.Query += " ORDER BY e.id"
-				break
-			case "rating":
-				q.Query += " ORDER BY e.rating"
-				break
-			case "price_asc":
-				q.Query += " ORDER BY e.min_price"
-				break
-			case "price_desc":
-				q.Query += " ORDER BY e.min_price DESC"
-				break
-			case "new":
-				q.Query += " ORDER BY e.created_at DESC"
-			}
-		}
-		if params.Offset != nil {
-			q.Query += fmt.Sprintf(" OFFSET $%d", idx)
-			filters = append(filters, *params.Offset)
-			idx++
-		}
+	sq.conditions = append(sq.conditions,
+		"ea.latitude is not null and ea.longitude is not null",
+		fmt.Sprintf("ea.latitude between $%d and $%d", idx, idx+1))
+	filters = append(filters, viewport.MinLat, viewport.MaxLat)
+	idx += 2
 
-		if params.Limit != nil {
-			q.Query += fmt.Sprintf(" LIMIT $%d", idx)
-			filters = append(filters, *params.Limit)
-		}
+	// bbox пересекает антимеридиан
+	if viewport.MinLon > viewport.MaxLon {
+		sq.conditions = append(sq.conditions, fmt.Sprintf("(ea.longitude >= $%d or ea.longitude <= $%d)", idx, idx+1))
+	} else {
+		sq.conditions = append(sq.conditions, fmt.Sprintf("ea.longitude between $%d and $%d", idx, idx+1))
+	}
+	filters = append(filters, viewport.MinLon, viewport.MaxLon)
+	idx += 2
 
+	q := db.Query{
+		Title: "event_repository.GetMapClusters",
+		Query: fmt.Sprintf(`select count(*) as count,
+				   avg(ea.latitude)::float8 as latitude,
+				   avg(ea.longitude)::float8 as longitude,
+				   (array_agg(e.id order by e.id))[1:$%d] as sample_ids
+				from events e
+				left join event_address ea on e.address_id = ea.id%s
+				group by floor(ea.latitude::float8 / $%d), floor(ea.longitude::float8 / $%d)
+				order by count desc, latitude, longitude
+				limit $%d`,
+			idx, sq.where(), idx+1, idx+1, idx+2),
 	}
+	filters = append(filters, domain.MapClusterSampleSize, viewport.CellSize(), domain.MapClustersLimit)
 
-	err := s.db.DB().ScanAllContext(ctx, &events, q, filters...)
+	err := s.db.DB().ScanAllContext(ctx, &clusters, q, filters...)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+
+	return converters.MapClustersFromRepoToDomain(clusters), nil
+}
 
+// ResolveCategories сопоставляет переданные коды и алиасы с кодами категорий
+func (s *repo) ResolveCategories(ctx context.Context, codes []string) (map[string]string, error) {
+	resolved := make([]*repoModel.ResolvedCategory, 0)
+	q := db.Query{
+		Title: "event_repository.ResolveCategories",
+		Query: `select i.code as input, c.code
+				from unnest($1::text[]) as i(code)
+				join categories c on c.code = i.code
+				union
+				select i.code as input, c.code
+				from unnest($1::text[]) as i(code)
+				join category_aliases ca on ca.alias = i.code
+				join categories c on c.id = ca.category_id`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &resolved, q, codes)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, q.Title)
 	}
 
-	return converters.EventsFromRepoToDomain(events), nil
+	result := make(map[string]string, len(resolved))
+	for _, r := range resolved {
+		result[r.Input] = r.Code
+	}
+	return result, nil
 }
-func (s *repo) GetFiltersData(ctx context.Context, userCountry string) (*domain.FiltersData, error) {
-	data := &repoModel.FiltersData{}
+
+func (s *repo) ListCategoryAliases(ctx context.Context) ([]*domain.CategoryAlias, error) {
+	aliases := make([]*repoModel.CategoryAlias, 0)
 	q := db.Query{
-		Title: "event_repository.GetFiltersData",
-		Query: `select min(e.min_price) as min_price, max(e.min_price) as max_price, 
-       			COALESCE(array_agg(distinct ea.city), '{}') as cities,
-       			COALESCE(jsonb_agg(
-                        DISTINCT jsonb_build_object(
-                       'code', ca.code,
-                       'title', ca.title)) FILTER (WHERE ca.id IS NOT NULL), '[]'::jsonb) AS categories
-				from events e
-				left join event_address ea on e.address_id = ea.id
-				left join event_categories ec on e.id = ec.event_id
-				left join categories ca on ec.category_id = ca.id
-				where ea.country = $1 and ea.city != '' and e.starts_at > now()
-				`,
+		Title: "event_repository.ListCategoryAliases",
+		Query: `select ca.alias, c.title, c.code
+				from category_aliases ca
+				join categories c on c.id = ca.category_id
+				order by ca.alias`,
 	}
-	err := s.db.DB().ScanOneContext(ctx, data, q, userCountry)
+	err := s.db.DB().ScanAllContext(ctx, &aliases, q)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.CategoryAliasesFromRepoToDomain(aliases), nil
+}
+
+func (s *repo) SetCategoryAlias(ctx context.Context, alias string, categoryCode string) error {
+	q := db.Query{
+		Title: "event_repository.SetCategoryAlias",
+		Query: `insert into category_aliases (alias, category_id)
+				select $1, c.id
+				from categories c
+				where c.code = $2
+				on conflict (alias) do update set category_id = excluded.category_id`,
+	}
+	res, err := s.db.DB().ExecContext(ctx, q, alias, categoryCode)
+	if err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	if res.RowsAffected() == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (s *repo) DeleteCategoryAlias(ctx context.Context, alias string) error {
+	q := db.Query{
+		Title: "event_repository.DeleteCategoryAlias",
+		Query: `delete from category_aliases where alias = $1`,
+	}
+	res, err := s.db.DB().ExecContext(ctx, q, alias)
+	if err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	if res.RowsAffected() == 0 {
+		return domain.ErrCategoryAliasNotFound
 	}
-	return converters.FiltersFromRepoToDomain(data), nil
+	return nil
+}
+
+func (s *repo) ListCurrencyRates(ctx context.Context) ([]*domain.CurrencyRate, error) {
+	rates := make([]*repoModel.CurrencyRate, 0)
+	q := db.Query{
+		Title: "event_repository.ListCurrencyRates",
+		Query: `select currency, rate::float8 as rate, updated_at
+				from currency_rates
+				order by currency`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &rates, q)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.CurrencyRatesFromRepoToDomain(rates), nil
+}
+
+func (s *repo) UpsertCurrencyRates(ctx context.Context, rates []*domain.CurrencyRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	currencies := make([]string, 0, len(rates))
+	values := make([]float64, 0, len(rates))
+	for _, r := range rates {
+		currencies = append(currencies, r.Currency)
+		values = append(values, r.Rate)
+	}
+
+	q := db.Query{
+		Title: "event_repository.UpsertCurrencyRates",
+		Query: `insert into currency_rates (currency, rate)
+				select * from unnest($1::varchar[], $2::numeric[])
+				on conflict (currency) do update
+				set rate = excluded.rate, updated_at = now()`,
+	}
+	if _, err := s.db.DB().ExecContext(ctx, q, currencies, values); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
 }
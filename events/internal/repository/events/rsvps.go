@@ -0,0 +1,195 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/events/converters"
+	repoModel "github.com/M1steryO/RelocatorEvents/events/internal/repository/events/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// LockEventSeats блокирует событие до конца транзакции: все изменения RSVP события идут по очереди.
+// Возвращает оставшиеся места (nil - без ограничений) и есть ли у события предстоящие сеансы.
+// Места считаются из вместимости и going_count под блокировкой, импорт и админка их не сбрасывают.
+func (s *repo) LockEventSeats(ctx context.Context, eventId int64) (*int32, bool, error) {
+	var total sql.NullInt32
+	var going int32
+	var upcoming bool
+	q := db.Query{
+		Title: "event_repository.LockEventSeats",
+		Query: `select e.seats_total, e.going_count,
+				       exists (select 1 from event_sessions es where es.event_id = e.id and es.starts_at > now()) as upcoming
+				from events e
+				where e.id = $1
+				for update`,
+	}
+	err := s.db.DB().QueryRowContext(ctx, q, eventId).Scan(&total, &going, &upcoming)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, domain.ErrEventNotFound
+		}
+		return nil, false, errors.Wrap(err, q.Title)
+	}
+	if !total.Valid {
+		return nil, upcoming, nil
+	}
+	return domain.SeatsLeft(&total.Int32, going), upcoming, nil
+}
+
+func (s *repo) GetRsvpStatus(ctx context.Context, userId, eventId int64) (*domain.RsvpStatus, error) {
+	var status string
+	q := db.Query{
+		Title: "event_repository.GetRsvpStatus",
+		Query: `select status from rsvps where user_id = $1 and event_id = $2`,
+	}
+	err := s.db.DB().QueryRowContext(ctx, q, userId, eventId).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, q.Title)
+	}
+	rsvpStatus := domain.RsvpStatus(status)
+	return &rsvpStatus, nil
+}
+
+// SetRsvpStatus сохраняет статус. updated_at меняется только вместе со статусом,
+// поэтому повторный "going" не сдвигает пользователя в конец листа ожидания.
+func (s *repo) SetRsvpStatus(ctx context.Context, userId, eventId int64, status domain.RsvpStatus) error {
+	q := db.Query{
+		Title: "event_repository.SetRsvpStatus",
+		Query: `insert into rsvps (user_id, event_id, status)
+				values ($1, $2, $3)
+				on conflict (user_id, event_id) do update
+				set status = excluded.status, updated_at = now()
+				where rsvps.status <> excluded.status`,
+	}
+	if _, err := s.db.DB().ExecContext(ctx, q, userId, eventId, string(status)); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+// UpdateAttendance сдвигает going_count, оставшиеся места считаются из него
+func (s *repo) UpdateAttendance(ctx context.Context, eventId int64, goingDelta int32) error {
+	if goingDelta == 0 {
+		return nil
+	}
+
+	q := db.Query{
+		Title: "event_repository.UpdateAttendance",
+		Query: `update events
+				set going_count = greatest(going_count + $2, 0)
+				where id = $1`,
+	}
+	if _, err := s.db.DB().ExecContext(ctx, q, eventId, goingDelta); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+// PromoteWaitlist переводит первых из листа ожидания в "going" и возвращает их id.
+// limit nil - поднять всех.
+func (s *repo) PromoteWaitlist(ctx context.Context, eventId int64, limit *int32) ([]int64, error) {
+	userIds := make([]int64, 0)
+	q := db.Query{
+		Title: "event_repository.PromoteWaitlist",
+		Query: `with next as (
+					select user_id
+					from rsvps
+					where event_id = $1 and status = 'waitlisted'
+					order by updated_at, user_id
+					limit $2
+				)
+				update rsvps r
+				set status = 'going', updated_at = now()
+				from next
+				where r.event_id = $1 and r.user_id = next.user_id
+				returning r.user_id`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &userIds, q, eventId, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return userIds, nil
+}
+
+// ListRsvps отдаёт RSVP пользователя на предстоящие события, ближайшие - первыми.
+// Ближайший сеанс считается при чтении: events.starts_at устаревает, когда сеанс проходит.
+// Момент отсчёта сохраняется в курсоре, чтобы ключ сортировки не менялся между страницами.
+func (s *repo) ListRsvps(ctx context.Context, userId int64, params *domain.RsvpParams) ([]*domain.Rsvp, *domain.Cursor, error) {
+	rsvps := make([]*repoModel.Rsvp, 0)
+
+	at := time.Now()
+	if params.After != nil && params.After.At != nil {
+		at = *params.After.At
+	}
+
+	conditions := "r.user_id = $1 and ns.next_starts_at is not null"
+	filters := []interface{}{userId, at}
+	idx := 3
+
+	if len(params.Statuses) > 0 {
+		statuses := make([]string, 0, len(params.Statuses))
+		for _, status := range params.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		conditions += fmt.Sprintf(" and r.status::text = any($%d)", idx)
+		filters = append(filters, statuses)
+		idx++
+	}
+
+	if params.After != nil && params.After.Key != nil {
+		conditions += fmt.Sprintf(" and (ns.next_starts_at, e.id) > ($%d::timestamptz, $%d)", idx, idx+1)
+		filters = append(filters, *params.After.Key, params.After.Id)
+		idx += 2
+	}
+
+	q := db.Query{
+		Title: "event_repository.ListRsvps",
+		Query: fmt.Sprintf(`select r.status as rsvp_status, r.updated_at as rsvp_updated_at,
+				   e.id, e.title, e.description, e.link, e.rating, 
+				   e.reviews_count, e.ratings_count, e.min_age, e.min_price, e.max_price, e.is_free, e.favorites_count, e.going_count,
+				   e.seats_total, e.type, ns.next_starts_at as starts_at, e.image_url, e.currency,
+				     ea.venue_name,ea.city,  ea.district,  ea.postal_code,  ea.country,  ea.full_address,  ea.latitude,  ea.longitude,
+				   to_char(ns.next_starts_at at time zone 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"') as sort_key
+				from rsvps r
+				join events e on e.id = r.event_id
+				cross join lateral (
+					select min(es.starts_at) as next_starts_at
+					from event_sessions es
+					where es.event_id = e.id and es.starts_at > $2
+				) ns
+				left join event_address ea on e.address_id = ea.id
+				where %s
+				order by ns.next_starts_at, e.id
+				limit $%d`, conditions, idx),
+	}
+	// берём на одну запись больше, чтобы понять, есть ли следующая страница
+	limit := *params.Limit
+	filters = append(filters, limit+1)
+
+	err := s.db.DB().ScanAllContext(ctx, &rsvps, q, filters...)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, q.Title)
+	}
+
+	var next *domain.Cursor
+	if int64(len(rsvps)) > limit {
+		rsvps = rsvps[:limit]
+		last := rsvps[len(rsvps)-1]
+		next = &domain.Cursor{
+			Sort: domain.RsvpsCursorSort,
+			Key:  &last.Event.SortKey.String,
+			Id:   last.Event.Id,
+			At:   &at,
+		}
+	}
+
+	return converters.RsvpsFromRepoToDomain(rsvps), next, nil
+}
@@ -0,0 +1,286 @@
+package events
+
+import (
+	"fmt"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"math"
+	"strings"
+	"time"
+)
+
+// searchQuery - условия фильтрации событий, общие для списка и карты
+type searchQuery struct {
+	conditions   []string
+	filters      []interface{}
+	idx          int
+	extraColumns string
+	tsQuery      string
+	distance     string
+	// currency - плейсхолдер валюты пользователя, добавляется при первом обращении к ценам
+	currency string
+}
+
+// price возвращает выражение цены события в валюте пользователя (в минорных единицах).
+// Без валюты цены сравниваются как есть.
+func (sq *searchQuery) price(column string, currency *string) string {
+	if currency == nil {
+		return column
+	}
+	if sq.currency == "" {
+		sq.currency = fmt.Sprintf("$%d", sq.idx)
+		sq.filters = append(sq.filters, *currency)
+		sq.idx++
+	}
+	return fmt.Sprintf("convert_price(%s, e.currency, %s)", column, sq.currency)
+}
+
+// sortPrice - минимальная цена события в валюте пользователя, null - цена неизвестна или курс не загружен
+func (sq *searchQuery) sortPrice(currency *string) string {
+	return fmt.Sprintf("case when e.is_free then 0 else %s end", sq.price("e.min_price", currency))
+}
+
+// arg добавляет значение в параметры запроса и возвращает его плейсхолдер
+func (sq *searchQuery) arg(value interface{}) string {
+	placeholder := fmt.Sprintf("$%d", sq.idx)
+	sq.filters = append(sq.filters, value)
+	sq.idx++
+	return placeholder
+}
+
+func (sq *searchQuery) where() string {
+	if len(sq.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(sq.conditions, " AND ")
+}
+
+func buildSearchQuery(params *domain.SearchParams, country string) *searchQuery {
+	sq := &searchQuery{idx: 1}
+	if params == nil {
+		return sq
+	}
+
+	if params.Categories != nil {
+		sq.conditions = append(sq.conditions, fmt.Sprintf(`
+					EXISTS (
+					SELECT 1 FROM event_categories ec
+					JOIN categories c ON ec.category_id = c.id
+					WHERE ec.event_id = e.id AND c.code = ANY($%d)
+					)`, sq.idx))
+		sq.filters = append(sq.filters, params.Categories)
+		sq.idx++
+	}
+
+	if params.Q != nil {
+		sq.tsQuery = fmt.Sprintf("(websearch_to_tsquery('russian', $%d) || websearch_to_tsquery('english', $%d))", sq.idx, sq.idx)
+		sq.conditions = append(sq.conditions, "e.search_vector @@ "+sq.tsQuery)
+		sq.extraColumns += fmt.Sprintf(`,
+				   ts_headline('russian', concat_ws(' ', e.title, e.description), %s,
+				       'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=20, MinWords=5') as highlight`, sq.tsQuery)
+		sq.filters = append(sq.filters, *params.Q)
+		sq.idx++
+	}
+
+	if params.Lat != nil && params.Lon != nil {
+		point := fmt.Sprintf("ll_to_earth($%d, $%d)", sq.idx, sq.idx+1)
+		location := "ll_to_earth(ea.latitude::float8, ea.longitude::float8)"
+		sq.filters = append(sq.filters, *params.Lat, *params.Lon)
+		sq.idx += 2
+
+		sq.distance = fmt.Sprintf("earth_distance(%s, %s)", point, location)
+		sq.extraColumns += `,
+				   ` + sq.distance + ` as distance_m`
+
+		if params.RadiusKm != nil {
+			sq.conditions = append(sq.conditions, fmt.Sprintf(
+				"ea.latitude is not null and ea.longitude is not null and earth_box(%s, $%d) @> %s and earth_distance(%s, %s) <= $%d",
+				point, sq.idx, location, point, location, sq.idx))
+			sq.filters = append(sq.filters, *params.RadiusKm*1000)
+			sq.idx++
+		}
+	}
+
+	if params.City != nil {
+		sq.conditions = append(sq.conditions, fmt.Sprintf("ea.city = $%d", sq.idx))
+		sq.filters = append(sq.filters, *params.City)
+		sq.idx++
+	}
+
+	if params.District != nil {
+		sq.conditions = append(sq.conditions, fmt.Sprintf("ea.district = $%d", sq.idx))
+		sq.filters = append(sq.filters, *params.District)
+		sq.idx++
+	}
+
+	// диапазон цен события должен пересекаться с диапазоном из фильтра.
+	// События с ценой в валюте без курса под ценовой фильтр не попадают.
+	if params.MinPrice != nil {
+		maxPrice := sq.price("coalesce(e.max_price, e.min_price)", params.Currency)
+		sq.conditions = append(sq.conditions, fmt.Sprintf("%s >= $%d", maxPrice, sq.idx))
+		sq.filters = append(sq.filters, *params.MinPrice)
+		sq.idx++
+	}
+
+	if params.MaxPrice != nil {
+		minPrice := sq.price("e.min_price", params.Currency)
+		sq.conditions = append(sq.conditions, fmt.Sprintf("(e.is_free OR %s <= $%d)", minPrice, sq.idx))
+		sq.filters = append(sq.filters, *params.MaxPrice)
+		sq.idx++
+	}
+
+	// событие подходит, если в диапазон попадает хотя бы один его сеанс
+	if params.StartsFrom != nil {
+		sessionRange := fmt.Sprintf("es.starts_at >= $%d", sq.idx)
+		sq.filters = append(sq.filters, *params.StartsFrom)
+		sq.idx++
+		if params.StartsTo != nil {
+			sessionRange += fmt.Sprintf(" AND es.starts_at < $%d", sq.idx)
+			sq.filters = append(sq.filters, *params.StartsTo)
+			sq.idx++
+		}
+		sq.conditions = append(sq.conditions, sessionExists(sessionRange))
+	} else {
+		now := time.Now()
+		sq.conditions = append(sq.conditions, sessionExists(fmt.Sprintf("es.starts_at > $%d", sq.idx)))
+		sq.filters = append(sq.filters, now)
+		sq.idx++
+	}
+
+	if params.EventType != nil {
+		sq.conditions = append(sq.conditions, fmt.Sprintf("e.type = $%d", sq.idx))
+		sq.filters = append(sq.filters, params.EventType.String())
+		sq.idx++
+	}
+
+	if country != "" {
+		sq.conditions = append(sq.conditions, fmt.Sprintf("ea.country = $%d", sq.idx))
+		sq.filters = append(sq.filters, country)
+		sq.idx++
+	}
+
+	return sq
+}
+
+func sessionExists(condition string) string {
+	return `
+					EXISTS (
+					SELECT 1 FROM event_sessions es
+					WHERE es.event_id = e.id AND ` + condition + `
+					)`
+}
+
+type listSort struct {
+	name string
+	// выражение ключа сортировки, пустое - сортировка только по id
+	key  string
+	cast string
+	desc bool
+}
+
+func buildListSort(params *domain.SearchParams, sq *searchQuery) listSort {
+	if params == nil || params.Sort == nil {
+		return listSort{}
+	}
+
+	sort := listSort{name: *params.Sort}
+	switch *params.Sort {
+	case domain.DistanceSort:
+		if sq.distance != "" {
+			sort.key, sort.cast = fmt.Sprintf("coalesce(%s, 'Infinity'::float8)", sq.distance), "float8"
+		}
+	case domain.RelevanceSort:
+		if sq.tsQuery != "" {
+			sort.key, sort.cast, sort.desc = fmt.Sprintf("ts_rank_cd(e.search_vector, %s)", sq.tsQuery), "real", true
+		}
+	case "rating":
+		sort.key, sort.cast = "coalesce(e.rating, 0)", "numeric"
+	// события без цены или с валютой без курса идут в конце в обоих направлениях
+	case "price_asc":
+		sort.key, sort.cast = fmt.Sprintf("coalesce(%s, %d)", sq.sortPrice(params.Currency), int64(math.MaxInt64)), "bigint"
+	case "price_desc":
+		sort.key, sort.cast, sort.desc = fmt.Sprintf("coalesce(%s, -1)", sq.sortPrice(params.Currency)), "bigint", true
+	case "new":
+		sort.key, sort.cast, sort.desc = "e.created_at", "timestamptz", true
+	case domain.PopularSort:
+		sort.key, sort.cast, sort.desc = "coalesce(ep.score, 0)::float8", "float8", true
+	case domain.RecommendedSort:
+		sort.key, sort.cast, sort.desc = sq.recommendScore(params), "float8", true
+	}
+	return sort
+}
+
+// recommendScore - оценка события для сортировки recommended, от 0 до 1:
+// совпадение категорий с интересами, близость к пользователю, скорость начала и рейтинг.
+// Совпавшие интересы и ближайший сеанс отдаются отдельными колонками для объяснения оценки.
+func (sq *searchQuery) recommendScore(params *domain.SearchParams) string {
+	at := time.Now()
+	if params.RecommendAt != nil {
+		at = *params.RecommendAt
+	}
+	atArg := sq.arg(at) + "::timestamptz"
+
+	matched := fmt.Sprintf(`array(
+					SELECT c.code FROM event_categories ec
+					JOIN categories c ON ec.category_id = c.id
+					WHERE ec.event_id = e.id AND c.code = ANY(%s::text[])
+					ORDER BY c.code
+					)`, sq.arg(params.Interests))
+	nextStartsAt := fmt.Sprintf(`(
+					SELECT min(es.starts_at) FROM event_sessions es
+					WHERE es.event_id = e.id AND es.starts_at > %s
+					)`, atArg)
+
+	sq.extraColumns += `,
+				   ` + matched + ` as matched_interests,
+				   ` + nextStartsAt + ` as next_starts_at`
+
+	interest := fmt.Sprintf("least(cardinality(%s), %d)::float8 / %d", matched, domain.RecommendMaxInterests, domain.RecommendMaxInterests)
+
+	// без координат близость - совпадение города события с городом пользователя
+	proximity := "0::float8"
+	if sq.distance != "" {
+		proximity = fmt.Sprintf("coalesce(1 / (1 + %s / %d), 0)", sq.distance, domain.RecommendProximityScaleM)
+	} else if params.UserCity != "" {
+		proximity = fmt.Sprintf("(ea.city = %s::text)::int::float8", sq.arg(params.UserCity))
+	}
+
+	// least защищает exp от underflow для далёких событий
+	soon := fmt.Sprintf("coalesce(exp(-least(extract(epoch from %s - %s)::float8 / %d, 50)), 0)",
+		nextStartsAt, atArg, int64(domain.RecommendSoonScale.Seconds()))
+
+	rating := fmt.Sprintf("coalesce(e.rating, 0)::float8 / %d", domain.RecommendMaxRating)
+
+	score := fmt.Sprintf("(%v * %s + %v * %s + %v * %s + %v * %s)::float8",
+		domain.RecommendInterestWeight, interest,
+		domain.RecommendProximityWeight, proximity,
+		domain.RecommendSoonWeight, soon,
+		domain.RecommendRatingWeight, rating)
+
+	sq.extraColumns += `,
+				   ` + score + ` as score`
+	return score
+}
+
+func (ls listSort) orderBy() string {
+	direction := ""
+	if ls.desc {
+		direction = " DESC"
+	}
+	if ls.key == "" {
+		return " ORDER BY e.id" + direction
+	}
+	return fmt.Sprintf(" ORDER BY %s%s, e.id%s", ls.key, direction, direction)
+}
+
+// after - условие keyset-пагинации: строки строго после курсора в порядке сортировки
+func (ls listSort) after(cursor *domain.Cursor, idx int) (string, []interface{}) {
+	op := ">"
+	if ls.desc {
+		op = "<"
+	}
+	if ls.key == "" || cursor.Key == nil {
+		return fmt.Sprintf("e.id %s $%d", op, idx), []interface{}{cursor.Id}
+	}
+	return fmt.Sprintf("(%s, e.id) %s ($%d::text::%s, $%d)", ls.key, op, idx, ls.cast, idx+1),
+		[]interface{}{*cursor.Key, cursor.Id}
+}
@@ -0,0 +1,146 @@
+package events
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/events/converters"
+	repoModel "github.com/M1steryO/RelocatorEvents/events/internal/repository/events/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+func (s *repo) GetEventSessions(ctx context.Context, eventId int64) ([]*domain.EventSession, error) {
+	sessions := make([]*repoModel.EventSession, 0)
+	q := db.Query{
+		Title: "event_repository.GetEventSessions",
+		Query: `select id, starts_at, ends_at, min_price, max_price, currency, seats_available
+				from event_sessions
+				where event_id = $1
+				order by starts_at`,
+	}
+	err := s.db.DB().ScanAllContext(ctx, &sessions, q, eventId)
+	if err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.EventSessionsFromRepoToDomain(sessions), nil
+}
+
+// sessionsChunkSize - сколько сеансов вставлять одним запросом:
+// у каждого сеанса 7 параметров, а в запросе их может быть не больше 65535
+const sessionsChunkSize = 1000
+
+// upsertEventSessions добавляет сеансы и обновляет уже известные (по времени начала).
+// Возвращает число добавленных или изменённых сеансов.
+func (s *repo) upsertEventSessions(ctx context.Context, eventId int64, sessions []*domain.EventSession) (int64, error) {
+	eventIds := make([]int64, len(sessions))
+	for i := range eventIds {
+		eventIds[i] = eventId
+	}
+
+	var affected int64
+	for from := 0; from < len(sessions); from += sessionsChunkSize {
+		to := min(from+sessionsChunkSize, len(sessions))
+		n, err := s.upsertEventSessionsChunk(ctx, eventIds[from:to], sessions[from:to])
+		if err != nil {
+			return 0, err
+		}
+		affected += n
+	}
+	return affected, nil
+}
+
+func (s *repo) upsertEventSessionsChunk(ctx context.Context, eventIds []int64, sessions []*domain.EventSession) (int64, error) {
+	values, args := sessionValues(eventIds, sessions)
+
+	q := db.Query{
+		Title: "event_repository.upsertEventSessions",
+		Query: `insert into event_sessions (event_id, starts_at, ends_at, min_price, max_price, currency, seats_available)
+				values ` + values + `
+				on conflict (event_id, starts_at) do update
+				set ends_at = coalesce(excluded.ends_at, event_sessions.ends_at),
+				    min_price = coalesce(excluded.min_price, event_sessions.min_price),
+				    max_price = coalesce(excluded.max_price, event_sessions.max_price),
+				    currency = coalesce(excluded.currency, event_sessions.currency),
+				    seats_available = coalesce(excluded.seats_available, event_sessions.seats_available),
+				    updated_at = now()
+				where (event_sessions.ends_at, event_sessions.min_price, event_sessions.max_price,
+				       event_sessions.currency, event_sessions.seats_available)
+				      is distinct from
+				      (coalesce(excluded.ends_at, event_sessions.ends_at), coalesce(excluded.min_price, event_sessions.min_price),
+				       coalesce(excluded.max_price, event_sessions.max_price),
+				       coalesce(excluded.currency, event_sessions.currency), coalesce(excluded.seats_available, event_sessions.seats_available))`,
+	}
+	res, err := s.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected(), nil
+}
+
+// SetEventSessions заменяет расписание события. Если from задан, сеансы до него не трогаются:
+// парсеры присылают только будущие даты, а прошедшие остаются в истории.
+// Возвращает число удалённых, добавленных и изменённых сеансов.
+func (s *repo) SetEventSessions(ctx context.Context, eventId int64, sessions []*domain.EventSession, from *time.Time) (int64, error) {
+	startsAt := make([]time.Time, 0, len(sessions))
+	for _, session := range sessions {
+		startsAt = append(startsAt, session.StartsAt)
+	}
+
+	q := db.Query{
+		Title: "event_repository.SetEventSessions",
+		Query: `delete from event_sessions
+				where event_id = $1 and starts_at <> all($2::timestamptz[])
+				  and ($3::timestamptz is null or starts_at >= $3)`,
+	}
+	res, err := s.db.DB().ExecContext(ctx, q, eventId, startsAt, from)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+
+	upserted, err := s.upsertEventSessions(ctx, eventId, sessions)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected() + upserted, nil
+}
+
+// CreateEventSessions вставляет сеансы пачкой: eventIds[i] получает сеанс sessions[i]
+func (s *repo) CreateEventSessions(ctx context.Context, eventIds []int64, sessions []*domain.EventSession) error {
+	for from := 0; from < len(sessions); from += sessionsChunkSize {
+		to := min(from+sessionsChunkSize, len(sessions))
+		if err := s.createEventSessionsChunk(ctx, eventIds[from:to], sessions[from:to]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *repo) createEventSessionsChunk(ctx context.Context, eventIds []int64, sessions []*domain.EventSession) error {
+	values, args := sessionValues(eventIds, sessions)
+	q := db.Query{
+		Title: "event_repository.CreateEventSessions",
+		Query: `insert into event_sessions (event_id, starts_at, ends_at, min_price, max_price, currency, seats_available)
+				values ` + values + `
+				on conflict (event_id, starts_at) do nothing`,
+	}
+	if _, err := s.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+func sessionValues(eventIds []int64, sessions []*domain.EventSession) (string, []interface{}) {
+	const columns = 7
+	values := make([]string, 0, len(sessions))
+	args := make([]interface{}, 0, len(sessions)*columns)
+	idx := 1
+	for i, session := range sessions {
+		values = append(values, placeholders(idx, columns))
+		idx += columns
+		args = append(args, eventIds[i], session.StartsAt, session.EndsAt,
+			session.MinPrice, session.MaxPrice, session.Currency, session.SeatsAvailable)
+	}
+	return strings.Join(values, ", "), args
+}
@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/outbox"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// Add пишет сообщения в outbox; вызывается внутри транзакции, изменившей агрегат.
+// Повтор с тем же dedup_key игнорируется.
+func (r *repo) Add(ctx context.Context, messages ...*domain.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	var (
+		args []any
+		sb   strings.Builder
+	)
+
+	sb.WriteString(`insert into outbox (aggregate_type, aggregate_id, event_type, dedup_key, payload, created_at) values `)
+
+	argPos := 1
+	for i, m := range messages {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(fmt.Sprintf("($%d,$%d,$%d,$%d,$%d::jsonb,$%d)", argPos, argPos+1, argPos+2, argPos+3, argPos+4, argPos+5))
+		args = append(args, m.AggregateType, m.AggregateId, m.EventType, m.DedupKey, string(m.Payload), m.CreatedAt)
+		argPos += 6
+	}
+	sb.WriteString(` on conflict (dedup_key) do nothing`)
+
+	q := db.Query{
+		Title: "outbox_repository.Add",
+		Query: sb.String(),
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
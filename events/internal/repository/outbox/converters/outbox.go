@@ -0,0 +1,23 @@
+package converters
+
+import (
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/outbox"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/outbox/model"
+)
+
+func MessagesFromRepoToDomain(messages []*model.Message) []*domain.Message {
+	result := make([]*domain.Message, 0, len(messages))
+	for _, m := range messages {
+		result = append(result, &domain.Message{
+			Id:            m.Id,
+			AggregateType: m.AggregateType,
+			AggregateId:   m.AggregateId,
+			EventType:     m.EventType,
+			DedupKey:      m.DedupKey,
+			Payload:       m.Payload,
+			Attempts:      m.Attempts,
+			CreatedAt:     m.CreatedAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,29 @@
+package outbox
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/outbox"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/outbox/converters"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/outbox/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+)
+
+// FetchPending блокирует неотправленные сообщения до конца транзакции,
+// skip locked позволяет запускать несколько реле параллельно
+func (r *repo) FetchPending(ctx context.Context, limit int) ([]*domain.Message, error) {
+	messages := make([]*model.Message, 0, limit)
+	q := db.Query{
+		Title: "outbox_repository.FetchPending",
+		Query: `select id, aggregate_type, aggregate_id, event_type, dedup_key, payload::text as payload, attempts, created_at
+				from outbox
+				where published_at is null and failed_at is null
+				order by id
+				limit $1
+				for update skip locked`,
+	}
+	if err := r.db.DB().ScanAllContext(ctx, &messages, q, limit); err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.MessagesFromRepoToDomain(messages), nil
+}
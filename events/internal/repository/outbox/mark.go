@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+)
+
+func (r *repo) MarkPublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	q := db.Query{
+		Title: "outbox_repository.MarkPublished",
+		Query: `update outbox
+				set published_at = now(), attempts = attempts + 1, last_error = null
+				where id = any($1::bigint[])`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, ids); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+// MarkFailed записывает неудачную попытку. Когда попыток набирается maxAttempts, сообщение помечается
+// failed_at и больше не отправляется; возвращает true в этом случае
+func (r *repo) MarkFailed(ctx context.Context, id int64, publishErr error, maxAttempts int) (bool, error) {
+	q := db.Query{
+		Title: "outbox_repository.MarkFailed",
+		Query: `update outbox
+				set attempts = attempts + 1, last_error = $2,
+				    failed_at = case when attempts + 1 >= $3 then now() end
+				where id = $1
+				returning failed_at is not null`,
+	}
+	var failed bool
+	if err := r.db.DB().QueryRowContext(ctx, q, id, publishErr.Error(), maxAttempts).Scan(&failed); err != nil {
+		return false, errors.Wrap(err, q.Title)
+	}
+	return failed, nil
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type Message struct {
+	Id            int64     `db:"id"`
+	AggregateType string    `db:"aggregate_type"`
+	AggregateId   int64     `db:"aggregate_id"`
+	EventType     string    `db:"event_type"`
+	DedupKey      string    `db:"dedup_key"`
+	Payload       []byte    `db:"payload"`
+	Attempts      int       `db:"attempts"`
+	CreatedAt     time.Time `db:"created_at"`
+}
@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"context"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// PurgePublished удаляет до limit сообщений, отправленных раньше before
+func (r *repo) PurgePublished(ctx context.Context, before time.Time, limit int) (int64, error) {
+	q := db.Query{
+		Title: "outbox_repository.PurgePublished",
+		Query: `delete from outbox
+				where id in (
+				    select id from outbox
+				    where published_at < $1
+				    order by published_at
+				    limit $2
+				)`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, before, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,13 @@
+package outbox
+
+import "github.com/M1steryO/platform_common/pkg/db"
+
+type repo struct {
+	db db.Client
+}
+
+func NewOutboxRepository(db db.Client) *repo {
+	return &repo{
+		db: db,
+	}
+}
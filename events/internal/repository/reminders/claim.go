@@ -0,0 +1,43 @@
+package reminders
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reminders/converters"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reminders/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// ClaimDue переводит наступившие напоминания в sending одним запросом и возвращает их.
+// Отправка идёт уже после коммита: если процесс упадёт посередине, напоминание останется в sending
+// и не уйдёт повторно. skip locked позволяет запускать несколько планировщиков параллельно
+func (r *repo) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*domain.Reminder, error) {
+	reminders := make([]*model.Reminder, 0, limit)
+	q := db.Query{
+		Title: "reminder_repository.ClaimDue",
+		Query: `with claimed as (
+				    update reminders
+				    set status = 'sending', attempts = attempts + 1, updated_at = now()
+				    where id in (select id
+				                 from reminders
+				                 where status = 'pending' and remind_at <= $1
+				                   and (not_before is null or not_before <= $1)
+				                 order by remind_at
+				                 limit $2
+				                 for update skip locked)
+				    returning id, user_id, event_id, session_id, remind_at, attempts)
+				select c.id, c.user_id, c.event_id, c.session_id, c.remind_at, c.attempts,
+				       e.title as event_title, e.link as event_link, es.starts_at, ea.venue_name
+				from claimed c
+				         join events e on e.id = c.event_id
+				         join event_sessions es on es.id = c.session_id
+				         left join event_address ea on ea.id = e.address_id
+				order by c.remind_at, c.id`,
+	}
+	if err := r.db.DB().ScanAllContext(ctx, &reminders, q, now, limit); err != nil {
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.RemindersFromRepoToDomain(reminders), nil
+}
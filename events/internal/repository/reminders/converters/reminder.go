@@ -0,0 +1,37 @@
+package converters
+
+import (
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reminders/model"
+)
+
+func SettingsFromRepoToDomain(settings *model.Settings) *domain.Settings {
+	return &domain.Settings{
+		UserId:      settings.UserId,
+		HoursBefore: settings.HoursBefore,
+		Enabled:     settings.Enabled,
+	}
+}
+
+func ReminderFromRepoToDomain(reminder *model.Reminder) *domain.Reminder {
+	return &domain.Reminder{
+		Id:         reminder.Id,
+		UserId:     reminder.UserId,
+		EventId:    reminder.EventId,
+		SessionId:  reminder.SessionId,
+		RemindAt:   reminder.RemindAt,
+		Attempts:   reminder.Attempts,
+		EventTitle: reminder.EventTitle,
+		EventLink:  reminder.EventLink,
+		StartsAt:   reminder.StartsAt,
+		VenueName:  reminder.VenueName,
+	}
+}
+
+func RemindersFromRepoToDomain(reminders []*model.Reminder) []*domain.Reminder {
+	result := make([]*domain.Reminder, 0, len(reminders))
+	for _, r := range reminders {
+		result = append(result, ReminderFromRepoToDomain(r))
+	}
+	return result
+}
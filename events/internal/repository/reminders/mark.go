@@ -0,0 +1,64 @@
+package reminders
+
+import (
+	"context"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"time"
+)
+
+func (r *repo) MarkSent(ctx context.Context, id int64) error {
+	q := db.Query{
+		Title: "reminder_repository.MarkSent",
+		Query: `update reminders
+				set status = 'sent', sent_at = now(), last_error = null, updated_at = now()
+				where id = $1 and status = 'sending'`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, id); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+// MarkRetry возвращает напоминание в очередь после временной ошибки доставки
+func (r *repo) MarkRetry(ctx context.Context, id int64, sendErr error, notBefore time.Time) error {
+	q := db.Query{
+		Title: "reminder_repository.MarkRetry",
+		Query: `update reminders
+				set status = 'pending', not_before = $3, last_error = $2, updated_at = now()
+				where id = $1 and status = 'sending'`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, id, sendErr.Error(), notBefore); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+func (r *repo) MarkFailed(ctx context.Context, id int64, sendErr error) error {
+	q := db.Query{
+		Title: "reminder_repository.MarkFailed",
+		Query: `update reminders
+				set status = 'failed', last_error = $2, updated_at = now()
+				where id = $1 and status = 'sending'`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, id, sendErr.Error()); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
+
+// FailStale закрывает напоминания, зависшие в sending после падения процесса. Дошло ли сообщение,
+// неизвестно, поэтому напоминание не повторяется: лучше потерять его, чем отправить дважды
+func (r *repo) FailStale(ctx context.Context, before time.Time) (int64, error) {
+	q := db.Query{
+		Title: "reminder_repository.FailStale",
+		Query: `update reminders
+				set status = 'failed', last_error = 'delivery state unknown: sending timed out', updated_at = now()
+				where status = 'sending' and updated_at < $1`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, before)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type Settings struct {
+	UserId      int64 `db:"user_id"`
+	HoursBefore int32 `db:"hours_before"`
+	Enabled     bool  `db:"enabled"`
+}
+
+type Reminder struct {
+	Id        int64     `db:"id"`
+	UserId    int64     `db:"user_id"`
+	EventId   int64     `db:"event_id"`
+	SessionId int64     `db:"session_id"`
+	RemindAt  time.Time `db:"remind_at"`
+	Attempts  int32     `db:"attempts"`
+
+	EventTitle string    `db:"event_title"`
+	EventLink  string    `db:"event_link"`
+	StartsAt   time.Time `db:"starts_at"`
+	VenueName  *string   `db:"venue_name"`
+}
@@ -0,0 +1,81 @@
+package reminders
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// ScheduleReminders создаёт напоминания на ближайшие сеансы событий из избранного и с rsvp going/interested
+// и переносит ещё не отправленные, если пользователь поменял настройки. Отменённое напоминание
+// возвращается в очередь, если событие снова отслеживается; начатые отправкой не трогаются никогда
+func (r *repo) ScheduleReminders(ctx context.Context, params *domain.ScheduleParams) (int64, error) {
+	q := db.Query{
+		Title: "reminder_repository.ScheduleReminders",
+		Query: `insert into reminders (user_id, event_id, session_id, remind_at)
+				select t.user_id, es.event_id, es.id,
+				       es.starts_at - make_interval(hours => coalesce(rs.hours_before, $2::int))
+				from (select user_id, event_id from favorites
+				      union
+				      select user_id, event_id from rsvps where status in ('going', 'interested')) t
+				         join event_sessions es on es.event_id = t.event_id
+				         left join reminder_settings rs on rs.user_id = t.user_id
+				where es.starts_at > $1 and es.starts_at <= $3
+				  and coalesce(rs.enabled, true)
+				on conflict (user_id, session_id) do update
+				    set remind_at  = excluded.remind_at,
+				        status     = 'pending',
+				        attempts   = 0,
+				        not_before = null,
+				        last_error = null,
+				        updated_at = now()
+				where reminders.status = 'cancelled'
+				   or (reminders.status = 'pending' and reminders.remind_at <> excluded.remind_at)`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, params.Now, params.DefaultHoursBefore, params.Horizon())
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected(), nil
+}
+
+// CancelReminders отменяет ожидающие напоминания, если событие убрано из избранного и rsvp,
+// или пользователь выключил напоминания
+func (r *repo) CancelReminders(ctx context.Context) (int64, error) {
+	q := db.Query{
+		Title: "reminder_repository.CancelReminders",
+		Query: `update reminders r
+				set status = 'cancelled', updated_at = now()
+				where r.status = 'pending'
+				  and ((not exists (select 1 from favorites f
+				                    where f.user_id = r.user_id and f.event_id = r.event_id)
+				        and not exists (select 1 from rsvps v
+				                        where v.user_id = r.user_id and v.event_id = r.event_id
+				                          and v.status in ('going', 'interested')))
+				       or exists (select 1 from reminder_settings rs
+				                  where rs.user_id = r.user_id and not rs.enabled))`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected(), nil
+}
+
+// ExpireReminders закрывает ожидающие напоминания о сеансах, которые уже начались
+func (r *repo) ExpireReminders(ctx context.Context, now time.Time) (int64, error) {
+	q := db.Query{
+		Title: "reminder_repository.ExpireReminders",
+		Query: `update reminders r
+				set status = 'expired', updated_at = now()
+				from event_sessions es
+				where es.id = r.session_id and r.status = 'pending' and es.starts_at <= $1`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, now)
+	if err != nil {
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,42 @@
+package reminders
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reminders/converters"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reminders/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+func (r *repo) GetSettings(ctx context.Context, userId int64) (*domain.Settings, error) {
+	var settings model.Settings
+	q := db.Query{
+		Title: "reminder_repository.GetSettings",
+		Query: `select user_id, hours_before, enabled
+				from reminder_settings
+				where user_id = $1`,
+	}
+	if err := r.db.DB().ScanOneContext(ctx, &settings, q, userId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrSettingsNotFound, q.Title)
+		}
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.SettingsFromRepoToDomain(&settings), nil
+}
+
+func (r *repo) UpsertSettings(ctx context.Context, settings *domain.Settings) error {
+	q := db.Query{
+		Title: "reminder_repository.UpsertSettings",
+		Query: `insert into reminder_settings (user_id, hours_before, enabled)
+				values ($1, $2, $3)
+				on conflict (user_id) do update
+				set hours_before = excluded.hours_before, enabled = excluded.enabled, updated_at = now()`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, settings.UserId, settings.HoursBefore, settings.Enabled); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
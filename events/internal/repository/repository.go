@@ -2,22 +2,106 @@ package repository
 
 import (
 	"context"
+	domainAnalytics "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
 	domainEvents "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	domainOutbox "github.com/M1steryO/RelocatorEvents/events/internal/domain/outbox"
+	domainReminders "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
 	domainReviews "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	"time"
 )
 
 type EventRepository interface {
 	Get(ctx context.Context, id int64) (*domainEvents.Event, error)
+	GetByLink(ctx context.Context, link string) (*domainEvents.Event, error)
+	GetEventCategories(ctx context.Context, eventId int64) ([]*domainEvents.EventCategory, error)
 	Create(ctx context.Context, event *domainEvents.Event, addressId int64) (int64, error)
-	GetList(ctx context.Context, params *domainEvents.SearchParams, country string) ([]*domainEvents.Event, error)
-	GetFiltersData(ctx context.Context, userCountry string) (*domainEvents.FiltersData, error)
-	UpdateRating(ctx context.Context, eventId int64, grade int) error
+	CreateBatch(ctx context.Context, events []*domainEvents.Event, addressIds []int64) ([]int64, error)
+	GetByLinks(ctx context.Context, links []string) ([]*domainEvents.Event, error)
+	Update(ctx context.Context, event *domainEvents.Event) (int64, error)
+	Delete(ctx context.Context, id int64) (int64, error)
+	GetList(ctx context.Context, params *domainEvents.SearchParams, country string) ([]*domainEvents.Event, *domainEvents.Cursor, error)
+	GetFiltersData(ctx context.Context, userCountry string, currency string) (*domainEvents.FiltersData, error)
+	GetMapClusters(ctx context.Context, params *domainEvents.SearchParams, country string, viewport *domainEvents.MapViewport) ([]*domainEvents.MapCluster, error)
+	Suggest(ctx context.Context, query string, country string, limit int64) ([]*domainEvents.Suggestion, error)
+	UpdateRating(ctx context.Context, eventId int64, countDelta, gradeDelta int) error
+	IncrementVersion(ctx context.Context, id int64) (int64, error)
 	CreateEventAddress(ctx context.Context, event *domainEvents.EventAddress) (int64, error)
-	CreateEventCategory(ctx context.Context, eventId int64, categoryCode string) error
+	CreateEventAddresses(ctx context.Context, addresses []*domainEvents.EventAddress) ([]int64, error)
+	UpdateEventAddress(ctx context.Context, addressId int64, address *domainEvents.EventAddress) error
+	SetEventAddress(ctx context.Context, eventId, addressId int64) error
+	DeleteEventAddress(ctx context.Context, addressId int64) error
+	CreateEventCategory(ctx context.Context, eventId int64, categoryCode string) (bool, error)
+	CreateEventCategories(ctx context.Context, eventIds []int64, codes []string) error
+	DeleteEventCategories(ctx context.Context, eventId int64) error
+	AddFavorite(ctx context.Context, userId, eventId int64) (bool, error)
+	RemoveFavorite(ctx context.Context, userId, eventId int64) (bool, error)
+	GetFavoriteEventIds(ctx context.Context, userId int64, eventIds []int64) ([]int64, error)
+	ListFavorites(ctx context.Context, userId int64, params *domainEvents.FavoritesParams) ([]*domainEvents.Event, *domainEvents.Cursor, error)
+	LockEventSeats(ctx context.Context, eventId int64) (*int32, bool, error)
+	GetRsvpStatus(ctx context.Context, userId, eventId int64) (*domainEvents.RsvpStatus, error)
+	SetRsvpStatus(ctx context.Context, userId, eventId int64, status domainEvents.RsvpStatus) error
+	UpdateAttendance(ctx context.Context, eventId int64, goingDelta int32) error
+	PromoteWaitlist(ctx context.Context, eventId int64, limit *int32) ([]int64, error)
+	ListRsvps(ctx context.Context, userId int64, params *domainEvents.RsvpParams) ([]*domainEvents.Rsvp, *domainEvents.Cursor, error)
+	GetEventSessions(ctx context.Context, eventId int64) ([]*domainEvents.EventSession, error)
+	SetEventSessions(ctx context.Context, eventId int64, sessions []*domainEvents.EventSession, from *time.Time) (int64, error)
+	CreateEventSessions(ctx context.Context, eventIds []int64, sessions []*domainEvents.EventSession) error
+	ResolveCategories(ctx context.Context, codes []string) (map[string]string, error)
+	ListCategoryAliases(ctx context.Context) ([]*domainEvents.CategoryAlias, error)
+	SetCategoryAlias(ctx context.Context, alias string, categoryCode string) error
+	DeleteCategoryAlias(ctx context.Context, alias string) error
+	ListCurrencyRates(ctx context.Context) ([]*domainEvents.CurrencyRate, error)
+	UpsertCurrencyRates(ctx context.Context, rates []*domainEvents.CurrencyRate) error
+	RefreshPopularity(ctx context.Context, params *domainEvents.PopularityParams) (int64, error)
+	GetPopularityRefreshedAt(ctx context.Context) (*time.Time, error)
+	ListTrending(ctx context.Context, params *domainEvents.TrendingParams) ([]*domainEvents.TrendingEvent, error)
+	EnsureCalendarFeed(ctx context.Context, userId int64, token string) (*domainEvents.CalendarFeed, error)
+	SetCalendarFeedToken(ctx context.Context, userId int64, token string) (*domainEvents.CalendarFeed, error)
+	GetCalendarFeedUser(ctx context.Context, token string) (int64, error)
+	ListCalendarEvents(ctx context.Context, userId int64, from time.Time) ([]*domainEvents.Event, error)
 }
 
 type ReviewRepository interface {
 	Create(ctx context.Context, eventId int64, authorId int64, review *domainReviews.Review) (int64, error)
 	CreateMedia(ctx context.Context, reviewId int64, media []*domainReviews.MediaAttachment) error
-	List(ctx context.Context, eventId int64) ([]*domainReviews.Review, error)
+	List(ctx context.Context, params *domainReviews.ListParams) ([]*domainReviews.Review, *domainReviews.Cursor, error)
+	GradeHistogram(ctx context.Context, eventId int64) (domainReviews.Histogram, error)
+	GetForUpdate(ctx context.Context, id int64) (*domainReviews.Review, error)
+	Update(ctx context.Context, id int64, review *domainReviews.Review) error
+	DeleteMedia(ctx context.Context, reviewId int64) error
+	Delete(ctx context.Context, id int64) error
+	AddHelpfulVote(ctx context.Context, reviewId, userId int64) (bool, error)
+	RemoveHelpfulVote(ctx context.Context, reviewId, userId int64) (bool, error)
+	UpdateHelpfulCount(ctx context.Context, reviewId int64, delta int) (int32, error)
+}
+
+type OutboxRepository interface {
+	Add(ctx context.Context, messages ...*domainOutbox.Message) error
+	FetchPending(ctx context.Context, limit int) ([]*domainOutbox.Message, error)
+	MarkPublished(ctx context.Context, ids []int64) error
+	MarkFailed(ctx context.Context, id int64, publishErr error, maxAttempts int) (bool, error)
+	PurgePublished(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+type AnalyticsRepository interface {
+	AddViews(ctx context.Context, views []*domainAnalytics.View) error
+	AddImpressions(ctx context.Context, impressions []*domainAnalytics.Impressions) error
+	AggregateDaily(ctx context.Context, from, to time.Time) (int64, error)
+	GetAggregationWatermark(ctx context.Context) (time.Time, error)
+	SetAggregationWatermark(ctx context.Context, day time.Time) error
+	GetDailyStats(ctx context.Context, params *domainAnalytics.StatsParams) ([]*domainAnalytics.DailyStats, error)
+	CountUniqueViewers(ctx context.Context, params *domainAnalytics.StatsParams) (int64, error)
+}
+
+type ReminderRepository interface {
+	GetSettings(ctx context.Context, userId int64) (*domainReminders.Settings, error)
+	UpsertSettings(ctx context.Context, settings *domainReminders.Settings) error
+	ScheduleReminders(ctx context.Context, params *domainReminders.ScheduleParams) (int64, error)
+	CancelReminders(ctx context.Context) (int64, error)
+	ExpireReminders(ctx context.Context, now time.Time) (int64, error)
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*domainReminders.Reminder, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, sendErr error, notBefore time.Time) error
+	MarkFailed(ctx context.Context, id int64, sendErr error) error
+	FailStale(ctx context.Context, before time.Time) (int64, error)
 }
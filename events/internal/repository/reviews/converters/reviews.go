@@ -26,6 +26,8 @@ func ReviewFromRepo(m *model.Review) *domain.Review {
 	}
 
 	return &domain.Review{
+		Id:            m.Id,
+		EventId:       m.EventId,
 		Grade:         m.Grade,
 		Advantages:    m.Advantages,
 		Disadvantages: m.Disadvantages,
@@ -33,6 +35,8 @@ func ReviewFromRepo(m *model.Review) *domain.Review {
 		Media:         media,
 		AuthorId:      m.AuthorId,
 		CreatedAt:     m.CreatedAt,
+		EditedAt:      m.EditedAt,
+		HelpfulCount:  m.HelpfulCount,
 	}
 }
 
@@ -42,6 +42,7 @@ func (r *repo) Create(ctx context.Context, eventId int64, authorId int64, review
 				return 0, errors.Wrap(pgErr, q.Title)
 			}
 		}
+		return 0, errors.Wrap(err, q.Title)
 	}
 
 	return reviewId, nil
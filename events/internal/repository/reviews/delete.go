@@ -0,0 +1,24 @@
+package reviews
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+)
+
+// Delete удаляет отзыв, вложения удаляются каскадом
+func (r *repo) Delete(ctx context.Context, id int64) error {
+	q := db.Query{
+		Title: "review_repository.Delete",
+		Query: `delete from reviews where id = $1`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, id)
+	if err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	if res.RowsAffected() == 0 {
+		return errors.Wrap(domain.ErrReviewNotFound, q.Title)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package reviews
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reviews/converters"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reviews/model"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+// GetForUpdate блокирует отзыв до конца транзакции: параллельные правки одного отзыва
+// не должны пересчитать рейтинг события от одной и той же старой оценки
+func (r *repo) GetForUpdate(ctx context.Context, id int64) (*domain.Review, error) {
+	var review model.Review
+	q := db.Query{
+		Title: "review_repository.GetForUpdate",
+		Query: `select r.id, r.event_id, r.author_id, r.grade, r.advantages, r.disadvantages, r.text, r.created_at, r.edited_at,
+				       coalesce((select jsonb_agg(jsonb_build_object('key', rm.storage_key, 'type', rm.media_type))
+				                 from reviews_media rm
+				                 where rm.review_id = r.id), '[]'::jsonb) as media_files
+				from reviews r
+				where r.id = $1
+				for update of r`,
+	}
+	if err := r.db.DB().ScanOneContext(ctx, &review, q, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrReviewNotFound, q.Title)
+		}
+		return nil, errors.Wrap(err, q.Title)
+	}
+	return converters.ReviewFromRepo(&review), nil
+}
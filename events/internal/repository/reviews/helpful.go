@@ -0,0 +1,61 @@
+package reviews
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+// AddHelpfulVote возвращает false, если пользователь уже голосовал за отзыв
+func (r *repo) AddHelpfulVote(ctx context.Context, reviewId, userId int64) (bool, error) {
+	q := db.Query{
+		Title: "review_repository.AddHelpfulVote",
+		Query: `insert into review_helpful_votes (review_id, user_id)
+				values ($1, $2)
+				on conflict do nothing`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, reviewId, userId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+			return false, errors.Wrap(domain.ErrReviewNotFound, q.Title)
+		}
+		return false, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+func (r *repo) RemoveHelpfulVote(ctx context.Context, reviewId, userId int64) (bool, error) {
+	q := db.Query{
+		Title: "review_repository.RemoveHelpfulVote",
+		Query: `delete from review_helpful_votes where review_id = $1 and user_id = $2`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, reviewId, userId)
+	if err != nil {
+		return false, errors.Wrap(err, q.Title)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+// UpdateHelpfulCount сдвигает счётчик голосов и возвращает новое значение
+func (r *repo) UpdateHelpfulCount(ctx context.Context, reviewId int64, delta int) (int32, error) {
+	var count int32
+	q := db.Query{
+		Title: "review_repository.UpdateHelpfulCount",
+		Query: `update reviews
+				set helpful_count = helpful_count + $2
+				where id = $1
+				returning helpful_count`,
+	}
+	err := r.db.DB().QueryRowContext(ctx, q, reviewId, delta).Scan(&count)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errors.Wrap(domain.ErrReviewNotFound, q.Title)
+		}
+		return 0, errors.Wrap(err, q.Title)
+	}
+	return count, nil
+}
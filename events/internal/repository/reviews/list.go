@@ -2,35 +2,114 @@ package reviews
 
 import (
 	"context"
+	"fmt"
 	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
 	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reviews/converters"
 	"github.com/M1steryO/RelocatorEvents/events/internal/repository/reviews/model"
 	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
 )
 
-func (r *repo) List(ctx context.Context, eventId int64) ([]*domain.Review, error) {
-	var reviewModels []*model.Review
+type reviewSort struct {
+	key  string
+	cast string
+	desc bool
+}
+
+func buildReviewSort(sort string) reviewSort {
+	switch sort {
+	case domain.SortHighest:
+		return reviewSort{key: "r.grade", cast: "int", desc: true}
+	case domain.SortLowest:
+		return reviewSort{key: "r.grade", cast: "int"}
+	case domain.SortHelpful:
+		return reviewSort{key: "r.helpful_count", cast: "int", desc: true}
+	case domain.SortWithMedia:
+		return reviewSort{key: "(select count(*) from reviews_media rm where rm.review_id = r.id)", cast: "bigint", desc: true}
+	default:
+		return reviewSort{key: "r.created_at", cast: "timestamptz", desc: true}
+	}
+}
+
+func (r *repo) List(ctx context.Context, params *domain.ListParams) ([]*domain.Review, *domain.Cursor, error) {
+	reviewModels := make([]*model.Review, 0)
+	sort := buildReviewSort(params.Sort)
+
+	conditions := "r.event_id = $1"
+	filters := []interface{}{params.EventId}
+	idx := 2
+
+	if params.WithPhotos {
+		conditions += ` and exists (select 1 from reviews_media rm where rm.review_id = r.id and rm.media_type = 'image')`
+	}
+
+	op, direction := ">", ""
+	if sort.desc {
+		op, direction = "<", " desc"
+	}
+	if params.After != nil && params.After.Key != nil {
+		conditions += fmt.Sprintf(" and (%s, r.id) %s ($%d::text::%s, $%d)", sort.key, op, idx, sort.cast, idx+1)
+		filters = append(filters, *params.After.Key, params.After.Id)
+		idx += 2
+	}
 
 	q := db.Query{
 		Title: "review_repository.List",
-		Query: `select r.author_id, r.grade, r.advantages, r.disadvantages, r.text, r.created_at,
-       		coalesce(
-			  jsonb_agg(
-				jsonb_build_object('key', rm.storage_key, 'type', rm.media_type)
-			  ) filter (where rm.id is not null),
-			  '[]'::jsonb
-			) as media_files
+		Query: fmt.Sprintf(`select r.id, r.event_id, r.author_id, r.grade, r.advantages, r.disadvantages, r.text,
+				       r.created_at, r.edited_at, r.helpful_count,
+				       coalesce((select jsonb_agg(jsonb_build_object('key', rm.storage_key, 'type', rm.media_type) order by rm.id)
+				                 from reviews_media rm
+				                 where rm.review_id = r.id), '[]'::jsonb) as media_files,
+				       (%s)::text as sort_key
 				from reviews r
-				left join reviews_media rm on r.id = rm.review_id
-				where r.event_id = $1
-				group by r.id`,
+				where %s
+				order by %s%s, r.id%s
+				limit $%d`, sort.key, conditions, sort.key, direction, direction, idx),
 	}
+	// берём на одну запись больше, чтобы понять, есть ли следующая страница
+	limit := *params.Limit
+	filters = append(filters, limit+1)
 
-	err := r.db.DB().ScanAllContext(ctx, &reviewModels, q, eventId)
-
+	err := r.db.DB().ScanAllContext(ctx, &reviewModels, q, filters...)
 	if err != nil {
-		return nil, err
+		return nil, nil, errors.Wrap(err, q.Title)
+	}
+
+	var next *domain.Cursor
+	if int64(len(reviewModels)) > limit {
+		reviewModels = reviewModels[:limit]
+		last := reviewModels[len(reviewModels)-1]
+		next = &domain.Cursor{
+			Sort: params.Sort,
+			Key:  last.SortKey,
+			Id:   last.Id,
+		}
+	}
+
+	return converters.ReviewsFromRepo(reviewModels), next, nil
+}
+
+// GradeHistogram считает отзывы события по оценкам
+func (r *repo) GradeHistogram(ctx context.Context, eventId int64) (domain.Histogram, error) {
+	var (
+		counts    []*model.GradeCount
+		histogram domain.Histogram
+	)
+	q := db.Query{
+		Title: "review_repository.GradeHistogram",
+		Query: `select grade, count(*) as count
+				from reviews
+				where event_id = $1
+				group by grade`,
+	}
+	if err := r.db.DB().ScanAllContext(ctx, &counts, q, eventId); err != nil {
+		return histogram, errors.Wrap(err, q.Title)
 	}
 
-	return converters.ReviewsFromRepo(reviewModels), nil
+	for _, c := range counts {
+		if c.Grade >= domain.MinGrade && c.Grade <= domain.MaxGrade {
+			histogram[c.Grade-domain.MinGrade] = c.Count
+		}
+	}
+	return histogram, nil
 }
@@ -8,6 +8,8 @@ type MediaAttachment struct {
 }
 
 type Review struct {
+	Id            int64              `db:"id"`
+	EventId       int64              `db:"event_id"`
 	AuthorId      int64              `db:"author_id"`
 	Grade         int                `db:"grade"`
 	Advantages    string             `db:"advantages"`
@@ -15,4 +17,13 @@ type Review struct {
 	Text          string             `db:"text"`
 	Media         []*MediaAttachment `db:"media_files"`
 	CreatedAt     time.Time          `db:"created_at"`
+	EditedAt      *time.Time         `db:"edited_at"`
+	HelpfulCount  int32              `db:"helpful_count"`
+	// SortKey - значение ключа сортировки для курсора
+	SortKey *string `db:"sort_key"`
+}
+
+type GradeCount struct {
+	Grade int   `db:"grade"`
+	Count int64 `db:"count"`
 }
@@ -0,0 +1,37 @@
+package reviews
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"github.com/pkg/errors"
+)
+
+func (r *repo) Update(ctx context.Context, id int64, review *domain.Review) error {
+	q := db.Query{
+		Title: "review_repository.Update",
+		Query: `update reviews
+				set grade = $2, advantages = $3, disadvantages = $4, text = $5, edited_at = now()
+				where id = $1`,
+	}
+	res, err := r.db.DB().ExecContext(ctx, q, id, review.Grade, review.Advantages, review.Disadvantages, review.Text)
+	if err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	if res.RowsAffected() == 0 {
+		return errors.Wrap(domain.ErrReviewNotFound, q.Title)
+	}
+	return nil
+}
+
+// DeleteMedia удаляет все вложения отзыва, при правке они записываются заново
+func (r *repo) DeleteMedia(ctx context.Context, reviewId int64) error {
+	q := db.Query{
+		Title: "review_repository.DeleteMedia",
+		Query: `delete from reviews_media where review_id = $1`,
+	}
+	if _, err := r.db.DB().ExecContext(ctx, q, reviewId); err != nil {
+		return errors.Wrap(err, q.Title)
+	}
+	return nil
+}
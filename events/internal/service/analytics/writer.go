@@ -0,0 +1,167 @@
+package analytics
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository"
+	"github.com/M1steryO/RelocatorEvents/events/internal/service"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// shutdownFlushTimeout - сколько ждём записи остатка буфера при остановке
+const shutdownFlushTimeout = 5 * time.Second
+
+type impressionKey struct {
+	day     time.Time
+	eventId int64
+}
+
+// writer копит просмотры и показы в памяти и пишет их пачками, чтобы не замедлять чтение событий.
+// При переполнении буфера просмотры отбрасываются: аналитика не должна влиять на ответы API.
+type writer struct {
+	repo      repository.AnalyticsRepository
+	txManager db.TxManager
+
+	views chan *domain.View
+
+	mu          sync.Mutex
+	impressions map[impressionKey]int64
+
+	batchSize         int
+	flushInterval     time.Duration
+	aggregateInterval time.Duration
+}
+
+func NewViewRecorder(repo repository.AnalyticsRepository, txManager db.TxManager,
+	bufferSize int, batchSize int, flushInterval time.Duration, aggregateInterval time.Duration) service.ViewRecorder {
+	return &writer{
+		repo:      repo,
+		txManager: txManager,
+
+		views:       make(chan *domain.View, bufferSize),
+		impressions: make(map[impressionKey]int64),
+
+		batchSize:         batchSize,
+		flushInterval:     flushInterval,
+		aggregateInterval: aggregateInterval,
+	}
+}
+
+func (w *writer) RecordView(view *domain.View) {
+	select {
+	case w.views <- view:
+	default:
+		logger.Warn("view buffer is full, dropping view", slog.Int64("event_id", view.EventId))
+	}
+}
+
+func (w *writer) RecordImpressions(eventIds []int64, at time.Time) {
+	day := domain.Day(at)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, id := range eventIds {
+		w.impressions[impressionKey{day: day, eventId: id}]++
+	}
+}
+
+func (w *writer) Run(ctx context.Context) error {
+	flushTicker := time.NewTicker(w.flushInterval)
+	defer flushTicker.Stop()
+	aggregateTicker := time.NewTicker(w.aggregateInterval)
+	defer aggregateTicker.Stop()
+
+	batch := make([]*domain.View, 0, w.batchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			// дописываем то, что уже в буфере
+			flushCtx, cancel := context.WithTimeout(context.Background(), shutdownFlushTimeout)
+			defer cancel()
+			for len(w.views) > 0 {
+				batch = append(batch, <-w.views)
+			}
+			w.flush(flushCtx, batch)
+			return nil
+
+		case view := <-w.views:
+			batch = append(batch, view)
+			if len(batch) >= w.batchSize {
+				w.flushViews(ctx, batch)
+				batch = batch[:0]
+			}
+
+		case <-flushTicker.C:
+			w.flush(ctx, batch)
+			batch = batch[:0]
+
+		case <-aggregateTicker.C:
+			if err := w.aggregate(ctx); err != nil {
+				logger.Error("view stats aggregation error", slog.String("err", err.Error()))
+			}
+		}
+	}
+}
+
+func (w *writer) flush(ctx context.Context, views []*domain.View) {
+	w.flushViews(ctx, views)
+
+	w.mu.Lock()
+	pending := w.impressions
+	w.impressions = make(map[impressionKey]int64)
+	w.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	impressions := make([]*domain.Impressions, 0, len(pending))
+	for key, count := range pending {
+		impressions = append(impressions, &domain.Impressions{Day: key.day, EventId: key.eventId, Count: count})
+	}
+	if err := w.repo.AddImpressions(ctx, impressions); err != nil {
+		logger.Error("failed to write impressions",
+			slog.Int("count", len(impressions)),
+			slog.String("err", err.Error()),
+		)
+	}
+}
+
+func (w *writer) flushViews(ctx context.Context, views []*domain.View) {
+	if len(views) == 0 {
+		return
+	}
+	if err := w.repo.AddViews(ctx, views); err != nil {
+		logger.Error("failed to write views",
+			slog.Int("count", len(views)),
+			slog.String("err", err.Error()),
+		)
+	}
+}
+
+// aggregate пересчитывает дни от отметки до сегодняшнего включительно и сдвигает отметку на вчера:
+// во вчерашний день ещё могут дописаться просмотры из буфера, а пропущенные дни (простой сервиса)
+// досчитаются при следующем запуске
+func (w *writer) aggregate(ctx context.Context) error {
+	today := domain.Day(time.Now())
+	yesterday := today.AddDate(0, 0, -1)
+
+	return w.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		from, err := w.repo.GetAggregationWatermark(ctx)
+		if err != nil {
+			return err
+		}
+		from = domain.Day(from)
+
+		if _, err = w.repo.AggregateDaily(ctx, from, today.AddDate(0, 0, 1)); err != nil {
+			return err
+		}
+		if yesterday.After(from) {
+			return w.repo.SetAggregationWatermark(ctx, yesterday)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,159 @@
+package events
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/utils/ical"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"strings"
+	"time"
+)
+
+const calendarTokenBytes = 32
+
+// ExportEventIcs возвращает событие одним VEVENT: ближайший предстоящий сеанс, а если их нет - последний
+func (s *serv) ExportEventIcs(ctx context.Context, id int64) ([]byte, error) {
+	event, err := s.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.db.GetEventSessions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &domain.EventSession{StartsAt: event.StartsAt}
+	for _, es := range sessions {
+		session = es
+		if es.StartsAt.After(now) {
+			break
+		}
+	}
+
+	calendar := &ical.Calendar{
+		ProdId: domain.CalendarProdId,
+		Events: []*ical.Event{toCalendarEvent(event, session, fmt.Sprintf("event-%d", event.Id), now)},
+	}
+	return calendar.Marshal(), nil
+}
+
+// GetCalendarFeed возвращает ссылку на личную ленту, при первом обращении создаёт её
+func (s *serv) GetCalendarFeed(ctx context.Context) (*domain.CalendarFeed, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return nil, errors.New("userId not found in context")
+	}
+
+	token, err := newCalendarToken()
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := s.db.EnsureCalendarFeed(ctx, userId, token)
+	if err != nil {
+		return nil, err
+	}
+	s.fillCalendarFeedURLs(feed)
+	return feed, nil
+}
+
+// ResetCalendarFeed выдаёт новый токен, подписки со старой ссылкой перестают обновляться
+func (s *serv) ResetCalendarFeed(ctx context.Context) (*domain.CalendarFeed, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return nil, errors.New("userId not found in context")
+	}
+
+	token, err := newCalendarToken()
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := s.db.SetCalendarFeedToken(ctx, userId, token)
+	if err != nil {
+		return nil, err
+	}
+	s.fillCalendarFeedURLs(feed)
+	return feed, nil
+}
+
+// GetCalendarFeedIcs собирает ленту по токену: каждый сеанс - отдельный VEVENT
+func (s *serv) GetCalendarFeedIcs(ctx context.Context, token string) ([]byte, error) {
+	userId, err := s.db.GetCalendarFeedUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	events, err := s.db.ListCalendarEvents(ctx, userId, now.Add(-domain.CalendarFeedHistory))
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := &ical.Calendar{
+		ProdId:          domain.CalendarProdId,
+		Name:            domain.CalendarName,
+		RefreshInterval: domain.CalendarFeedRefresh,
+	}
+	for _, event := range events {
+		for _, session := range event.Sessions {
+			uid := fmt.Sprintf("event-%d-session-%d", event.Id, session.Id)
+			calendar.Events = append(calendar.Events, toCalendarEvent(event, session, uid, now))
+		}
+	}
+	return calendar.Marshal(), nil
+}
+
+func (s *serv) fillCalendarFeedURLs(feed *domain.CalendarFeed) {
+	feed.HttpURL = s.calendarFeedURL + "/" + feed.Token + ".ics"
+	feed.WebcalURL = feed.HttpURL
+	for _, scheme := range []string{"https://", "http://"} {
+		if strings.HasPrefix(feed.HttpURL, scheme) {
+			feed.WebcalURL = "webcal://" + strings.TrimPrefix(feed.HttpURL, scheme)
+			break
+		}
+	}
+}
+
+func toCalendarEvent(event *domain.Event, session *domain.EventSession, uid string, stamp time.Time) *ical.Event {
+	result := &ical.Event{
+		UID:     uid + "@" + domain.CalendarUIDDomain,
+		Start:   session.StartsAt,
+		End:     session.EndsAt,
+		Stamp:   stamp,
+		Summary: event.Title,
+		URL:     event.Link,
+	}
+	if event.Description != nil {
+		result.Description = *event.Description
+	}
+
+	if a := event.Address; a != nil {
+		location := make([]string, 0, 2)
+		if a.VenueName != nil && *a.VenueName != "" {
+			location = append(location, *a.VenueName)
+		}
+		if a.FullAddress != "" {
+			location = append(location, a.FullAddress)
+		}
+		result.Location = strings.Join(location, ", ")
+
+		if a.Latitude != nil && a.Longitude != nil {
+			result.Geo = &ical.Geo{Lat: *a.Latitude, Lon: *a.Longitude}
+		}
+	}
+	return result
+}
+
+func newCalendarToken() (string, error) {
+	buf := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,68 @@
+package events
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	"log/slog"
+	"strings"
+)
+
+func normalizeCategoryCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// resolveCategories переводит коды и алиасы категорий в коды из таблицы categories,
+// неизвестные значения логируются и пропускаются
+func (s *serv) resolveCategories(ctx context.Context, categories []string) ([]string, error) {
+	resolved, err := s.resolveCategoryCodes(ctx, categories)
+	if err != nil {
+		return nil, err
+	}
+	return pickCategories(resolved, categories), nil
+}
+
+// resolveCategoryCodes возвращает соответствие нормализованных входных значений кодам категорий
+func (s *serv) resolveCategoryCodes(ctx context.Context, categories []string) (map[string]string, error) {
+	codes := make([]string, 0, len(categories))
+	for _, c := range categories {
+		if c = normalizeCategoryCode(c); c != "" {
+			codes = append(codes, c)
+		}
+	}
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	resolved, err := s.db.ResolveCategories(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	var unknown []string
+	for _, c := range codes {
+		if _, ok := resolved[c]; !ok {
+			unknown = append(unknown, c)
+		}
+	}
+	if len(unknown) > 0 {
+		logger.Warn("unknown event categories", slog.Any("codes", unknown))
+	}
+	return resolved, nil
+}
+
+func pickCategories(resolved map[string]string, categories []string) []string {
+	result := make([]string, 0, len(categories))
+	seen := make(map[string]struct{}, len(categories))
+	for _, c := range categories {
+		code, ok := resolved[normalizeCategoryCode(c)]
+		if !ok {
+			continue
+		}
+		if _, ok = seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		result = append(result, code)
+	}
+	return result
+}
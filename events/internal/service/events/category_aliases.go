@@ -0,0 +1,18 @@
+package events
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+)
+
+func (s *serv) ListCategoryAliases(ctx context.Context) ([]*domain.CategoryAlias, error) {
+	return s.db.ListCategoryAliases(ctx)
+}
+
+func (s *serv) SetCategoryAlias(ctx context.Context, alias string, categoryCode string) error {
+	return s.db.SetCategoryAlias(ctx, normalizeCategoryCode(alias), normalizeCategoryCode(categoryCode))
+}
+
+func (s *serv) DeleteCategoryAlias(ctx context.Context, alias string) error {
+	return s.db.DeleteCategoryAlias(ctx, normalizeCategoryCode(alias))
+}
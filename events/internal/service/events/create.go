@@ -5,8 +5,12 @@ import (
 	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
 )
 
-func (s *serv) Create(ctx context.Context, event *domain.Event, category string) (int64, error) {
+func (s *serv) Create(ctx context.Context, event *domain.Event, categories []string) (int64, error) {
 	var eventId int64
+	event.EnsureSessions()
+	if err := event.ValidateSessions(); err != nil {
+		return 0, err
+	}
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		addressId, err := s.db.CreateEventAddress(ctx, event.Address)
 
@@ -19,12 +23,24 @@ func (s *serv) Create(ctx context.Context, event *domain.Event, category string)
 			return err
 		}
 
-		err = s.db.CreateEventCategory(ctx, eventId, category)
+		if _, err = s.db.SetEventSessions(ctx, eventId, event.Sessions, nil); err != nil {
+			return err
+		}
+
+		codes, err := s.resolveCategories(ctx, categories)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		for _, category := range codes {
+			_, err = s.db.CreateEventCategory(ctx, eventId, category)
+			if err != nil {
+				return err
+			}
+		}
+
+		event.Id = eventId
+		return s.addEventCreated(ctx, event, codes)
 	})
 	if err != nil {
 		return 0, err
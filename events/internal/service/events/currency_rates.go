@@ -0,0 +1,82 @@
+package events
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+func (s *serv) ListCurrencyRates(ctx context.Context) ([]*domain.CurrencyRate, error) {
+	return s.db.ListCurrencyRates(ctx)
+}
+
+// ImportCurrencyRates загружает курсы из CSV "currency,rate". Файл применяется целиком
+// или не применяется вовсе; курсы, которых нет в файле, остаются прежними.
+func (s *serv) ImportCurrencyRates(ctx context.Context, file string) (int64, error) {
+	rates, err := parseCurrencyRates(file)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.db.UpsertCurrencyRates(ctx, rates); err != nil {
+		return 0, err
+	}
+
+	logger.Info("currency rates imported", slog.Int("count", len(rates)))
+	return int64(len(rates)), nil
+}
+
+func parseCurrencyRates(file string) ([]*domain.CurrencyRate, error) {
+	r := csv.NewReader(strings.NewReader(file))
+	r.Comment = '#'
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+
+	// в файле одна валюта может встречаться несколько раз, побеждает последняя строка
+	byCurrency := make(map[string]int)
+	rates := make([]*domain.CurrencyRate, 0)
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidCurrencyRates, err.Error())
+		}
+
+		currency := domain.NormalizeCurrency(record[0])
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil {
+			if line == 1 {
+				// строка заголовка
+				continue
+			}
+			return nil, fmt.Errorf("%w: line %d: rate %q is not a number", domain.ErrInvalidCurrencyRates, line, record[1])
+		}
+		if utf8.RuneCountInString(currency) != 3 {
+			return nil, fmt.Errorf("%w: line %d: currency %q must be a 3-letter code", domain.ErrInvalidCurrencyRates, line, record[0])
+		}
+		if rate <= 0 {
+			return nil, fmt.Errorf("%w: line %d: rate must be positive", domain.ErrInvalidCurrencyRates, line)
+		}
+
+		if i, ok := byCurrency[currency]; ok {
+			rates[i].Rate = rate
+			continue
+		}
+		byCurrency[currency] = len(rates)
+		rates = append(rates, &domain.CurrencyRate{Currency: currency, Rate: rate})
+	}
+
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w: file has no rates", domain.ErrInvalidCurrencyRates)
+	}
+	return rates, nil
+}
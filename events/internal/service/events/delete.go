@@ -0,0 +1,20 @@
+package events
+
+import (
+	"context"
+)
+
+func (s *serv) Delete(ctx context.Context, id int64) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		addressId, err := s.db.Delete(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if addressId == 0 {
+			return nil
+		}
+
+		return s.db.DeleteEventAddress(ctx, addressId)
+	})
+}
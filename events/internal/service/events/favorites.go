@@ -0,0 +1,102 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/utils/cursor"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"log/slog"
+)
+
+func (s *serv) AddFavorite(ctx context.Context, eventId int64) error {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return errors.New("userId not found in context")
+	}
+
+	added, err := s.db.AddFavorite(ctx, userId, eventId)
+	if err != nil {
+		return err
+	}
+	if added {
+		logger.Debug("favorite added", slog.Int64("user_id", userId), slog.Int64("event_id", eventId))
+	}
+	return nil
+}
+
+func (s *serv) RemoveFavorite(ctx context.Context, eventId int64) error {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return errors.New("userId not found in context")
+	}
+
+	removed, err := s.db.RemoveFavorite(ctx, userId, eventId)
+	if err != nil {
+		return err
+	}
+	if removed {
+		logger.Debug("favorite removed", slog.Int64("user_id", userId), slog.Int64("event_id", eventId))
+	}
+	return nil
+}
+
+func (s *serv) ListFavorites(ctx context.Context, params *domain.FavoritesParams) (*domain.EventsList, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return nil, errors.New("userId not found in context")
+	}
+
+	params.Limit = pageLimit(params.Limit)
+	filters, err := cursor.Hash(params.IncludePast)
+	if err != nil {
+		return nil, err
+	}
+	after, err := s.decodeCursor(params.Cursor, domain.FavoritesCursorSort, filters)
+	if err != nil {
+		return nil, err
+	}
+	params.After = after
+
+	events, next, err := s.db.ListFavorites(ctx, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		e.IsFavorite = true
+	}
+
+	list := &domain.EventsList{Data: events}
+	list.NextCursor, err = s.encodeCursor(next, filters)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// markFavorites отмечает события из избранного текущего пользователя
+func (s *serv) markFavorites(ctx context.Context, events []*domain.Event) error {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok || len(events) == 0 {
+		return nil
+	}
+
+	eventIds := make([]int64, 0, len(events))
+	for _, e := range events {
+		eventIds = append(eventIds, e.Id)
+	}
+
+	favoriteIds, err := s.db.GetFavoriteEventIds(ctx, userId, eventIds)
+	if err != nil {
+		return err
+	}
+
+	favorites := make(map[int64]struct{}, len(favoriteIds))
+	for _, id := range favoriteIds {
+		favorites[id] = struct{}{}
+	}
+	for _, e := range events {
+		_, e.IsFavorite = favorites[e.Id]
+	}
+	return nil
+}
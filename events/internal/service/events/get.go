@@ -3,15 +3,37 @@ package events
 import (
 	"context"
 	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domainAnalytics "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
 	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
 	"log/slog"
+	"time"
 )
 
-func (s *serv) Get(ctx context.Context, id int64) (*domain.Event, error) {
+func (s *serv) Get(ctx context.Context, id int64, source domainAnalytics.ViewSource) (*domain.Event, error) {
 	event, err := s.db.Get(ctx, id)
 	if err != nil {
 		logger.Error("error getting event", slog.String("error", err.Error()))
 		return nil, err
 	}
+
+	event.Sessions, err = s.db.GetEventSessions(ctx, id)
+	if err != nil {
+		logger.Error("error getting event sessions", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err = s.markFavorites(ctx, []*domain.Event{event}); err != nil {
+		logger.Error("error getting favorites", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if userId, ok := ctx.Value("userId").(int64); ok {
+		s.views.RecordView(&domainAnalytics.View{
+			EventId:  id,
+			UserId:   userId,
+			Source:   source,
+			ViewedAt: time.Now(),
+		})
+	}
 	return event, nil
 }
@@ -0,0 +1,15 @@
+package events
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+)
+
+func (s *serv) GetMap(ctx context.Context, params *domain.SearchParams, viewport *domain.MapViewport) ([]*domain.MapCluster, error) {
+	userCountry, err := s.applyUserLocation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.db.GetMapClusters(ctx, params, userCountry, viewport)
+}
@@ -2,33 +2,46 @@ package events
 
 import (
 	"context"
-	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/utils/cursor"
 	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"time"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
 )
 
 func (s *serv) GetList(ctx context.Context, params *domain.SearchParams) (*domain.EventsList, error) {
 	var (
 		events      []*domain.Event
+		next        *domain.Cursor
 		filtersData *domain.FiltersData
 		err         error
 	)
-	userId, ok := ctx.Value("userId").(int64)
-	if !ok {
-		return nil, errors.New("userId not found in context")
+	filters, err := s.preparePage(params)
+	if err != nil {
+		return nil, err
 	}
 
-	userCountry, err := s.userClient.GetUserCountry(ctx, userId)
+	userCountry, err := s.applyUserLocation(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		events, err = s.db.GetList(ctx, params, userCountry)
+		if params.Sort != nil && *params.Sort == domain.PopularSort {
+			if err = s.preparePopularPage(ctx, params); err != nil {
+				return err
+			}
+		}
+
+		events, next, err = s.db.GetList(ctx, params, userCountry)
 		if err != nil {
 			return err
 		}
 
-		filtersData, err = s.db.GetFiltersData(ctx, userCountry)
+		filtersData, err = s.db.GetFiltersData(ctx, userCountry, *params.Currency)
 		if err != nil {
 			return err
 		}
@@ -39,8 +52,127 @@ func (s *serv) GetList(ctx context.Context, params *domain.SearchParams) (*domai
 	if err != nil {
 		return nil, err
 	}
-	return &domain.EventsList{
+
+	if err = s.markFavorites(ctx, events); err != nil {
+		return nil, err
+	}
+	explainRecommendations(events, params)
+	s.recordImpressions(events)
+
+	list := &domain.EventsList{
 		Data:    events,
 		Filters: filtersData,
-	}, nil
+	}
+	list.NextCursor, err = s.encodeCursor(next, filters)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// preparePage проверяет сортировку, выставляет лимит страницы и раскодирует курсор из запроса.
+// Возвращает отпечаток фильтров для курсора следующей страницы.
+// Считается до applyUserLocation, пока в params только то, что прислал клиент.
+func (s *serv) preparePage(params *domain.SearchParams) (string, error) {
+	if err := params.ValidateSort(); err != nil {
+		return "", err
+	}
+	params.Limit = pageLimit(params.Limit)
+
+	sort := ""
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	filters, err := cursor.Hash(params.Filters())
+	if err != nil {
+		return "", err
+	}
+
+	after, err := s.decodeCursor(params.Cursor, sort, filters)
+	if err != nil {
+		return "", err
+	}
+	params.After = after
+	return filters, nil
+}
+
+// decodeCursor проверяет подпись курсора и то, что он выдан для той же сортировки и тех же фильтров
+func (s *serv) decodeCursor(token *string, sort, filters string) (*domain.Cursor, error) {
+	if token == nil || *token == "" {
+		return nil, nil
+	}
+
+	after := &domain.Cursor{}
+	if err := cursor.Decode(*token, s.cursorSecret, after); err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+	if after.Sort != sort || after.Filters != filters {
+		return nil, domain.ErrInvalidCursor
+	}
+	return after, nil
+}
+
+func (s *serv) encodeCursor(next *domain.Cursor, filters string) (*string, error) {
+	if next == nil {
+		return nil, nil
+	}
+	next.Filters = filters
+	token, err := cursor.Encode(next, s.cursorSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// preparePopularPage привязывает страницу sort=popular к текущему расчёту популярности.
+// Пересчёт переписывает оценки, и курсор от прошлого расчёта пропускал бы и повторял события.
+// Момент расчёта читается до списка: если пересчёт успеет между ними, курсор окажется устаревшим, а не неверным.
+func (s *serv) preparePopularPage(ctx context.Context, params *domain.SearchParams) error {
+	refreshedAt, err := s.db.GetPopularityRefreshedAt(ctx)
+	if err != nil {
+		return err
+	}
+	if params.After != nil && !sameMoment(params.After.At, refreshedAt) {
+		return domain.ErrStaleCursor
+	}
+	params.PopularAt = refreshedAt
+	return nil
+}
+
+func sameMoment(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+func pageLimit(requested *int64) *int64 {
+	limit := int64(defaultListLimit)
+	if requested != nil && *requested > 0 {
+		limit = min(*requested, maxListLimit)
+	}
+	return &limit
+}
+
+func explainRecommendations(events []*domain.Event, params *domain.SearchParams) {
+	if params.RecommendAt == nil {
+		return
+	}
+	for _, event := range events {
+		if event.Recommendation != nil {
+			event.Recommendation.Explain(event, params.UserCity, *params.RecommendAt)
+		}
+	}
+}
+
+func (s *serv) recordImpressions(events []*domain.Event) {
+	if len(events) == 0 {
+		return
+	}
+	eventIds := make([]int64, 0, len(events))
+	for _, e := range events {
+		eventIds = append(eventIds, e.Id)
+	}
+	s.views.RecordImpressions(eventIds, time.Now())
 }
@@ -0,0 +1,49 @@
+package events
+
+import (
+	"context"
+	"errors"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"time"
+)
+
+// applyUserLocation подставляет страну, часовой пояс и валюту пользователя и переводит фильтр дат в диапазон.
+// Для сортировки recommended подставляет интересы и город пользователя.
+func (s *serv) applyUserLocation(ctx context.Context, params *domain.SearchParams) (string, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return "", errors.New("userId not found in context")
+	}
+
+	profile, err := s.userClient.GetUserProfile(ctx, userId)
+	if err != nil {
+		return "", err
+	}
+
+	if params.Sort != nil && *params.Sort == domain.RecommendedSort {
+		params.Interests = profile.Interests
+		params.UserCity = profile.City
+		// следующие страницы считаются от того же момента, что и первая
+		at := time.Now()
+		if params.After != nil && params.After.At != nil {
+			at = *params.After.At
+		}
+		params.RecommendAt = &at
+	}
+
+	params.Timezone = domain.ResolveTimezone(profile.Country, profile.City)
+	currency := domain.ResolveCurrency(params.Currency, profile.Country)
+	params.Currency = &currency
+	if params.EventDate != nil {
+		from, to, err := params.EventDate.ToRange(params.Timezone)
+		if err != nil {
+			return "", err
+		}
+		params.StartsFrom = &from
+		if !to.IsZero() {
+			params.StartsTo = &to
+		}
+	}
+
+	return profile.Country, nil
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/domain/outbox"
+	"time"
+)
+
+func eventPayload(event *domain.Event, categories []string, changes []domain.FieldChange) outbox.EventPayload {
+	payload := outbox.EventPayload{
+		Id:         event.Id,
+		Title:      event.Title,
+		Link:       event.Link,
+		StartsAt:   event.StartsAt,
+		ImageUrl:   event.ImageUrl,
+		Categories: categories,
+	}
+	if event.Address != nil {
+		payload.Country = event.Address.Country
+		payload.City = event.Address.City
+	}
+	for _, c := range changes {
+		payload.Changes = append(payload.Changes, c.Field)
+	}
+	return payload
+}
+
+func eventCreatedMessage(event *domain.Event, categories []string) (*outbox.Message, error) {
+	return outbox.NewMessage(outbox.AggregateEvent, event.Id, outbox.EventCreated,
+		fmt.Sprintf("%s:%d", outbox.EventCreated, event.Id),
+		eventPayload(event, categories, nil))
+}
+
+// событие может обновляться много раз, поэтому ключ включает версию события после изменения
+func eventUpdatedMessage(event *domain.Event, version int64, changes []domain.FieldChange) (*outbox.Message, error) {
+	return outbox.NewMessage(outbox.AggregateEvent, event.Id, outbox.EventUpdated,
+		fmt.Sprintf("%s:%d:%d", outbox.EventUpdated, event.Id, version),
+		eventPayload(event, nil, changes))
+}
+
+func (s *serv) addEventCreated(ctx context.Context, event *domain.Event, categories []string) error {
+	msg, err := eventCreatedMessage(event, categories)
+	if err != nil {
+		return err
+	}
+	return s.outbox.Add(ctx, msg)
+}
+
+func (s *serv) addEventUpdated(ctx context.Context, event *domain.Event, changes []domain.FieldChange) error {
+	version, err := s.db.IncrementVersion(ctx, event.Id)
+	if err != nil {
+		return err
+	}
+	msg, err := eventUpdatedMessage(event, version, changes)
+	if err != nil {
+		return err
+	}
+	return s.outbox.Add(ctx, msg)
+}
+
+func (s *serv) addRsvpPromoted(ctx context.Context, eventId int64, userIds []int64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	now := time.Now().UnixNano()
+	messages := make([]*outbox.Message, 0, len(userIds))
+	for _, userId := range userIds {
+		msg, err := outbox.NewMessage(outbox.AggregateEvent, eventId, outbox.RsvpPromoted,
+			fmt.Sprintf("%s:%d:%d:%d", outbox.RsvpPromoted, eventId, userId, now),
+			outbox.RsvpPayload{EventId: eventId, UserId: userId, Status: string(domain.RsvpGoing)})
+		if err != nil {
+			return err
+		}
+		messages = append(messages, msg)
+	}
+	return s.outbox.Add(ctx, messages...)
+}
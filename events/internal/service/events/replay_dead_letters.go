@@ -0,0 +1,32 @@
+package events
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/kafka"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	"log/slog"
+)
+
+const (
+	defaultReplayLimit = 100
+	maxReplayLimit     = 1000
+)
+
+func (s *serv) ReplayDeadLetters(ctx context.Context, limit int64) (*kafka.ReplayResult, error) {
+	if limit <= 0 {
+		limit = defaultReplayLimit
+	}
+	limit = min(limit, maxReplayLimit)
+
+	result, err := s.deadLetters.Replay(ctx, int(limit))
+	if result != nil {
+		logger.Info("dead letters replayed",
+			slog.Int("replayed", result.Replayed),
+			slog.Int("skipped", result.Skipped),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
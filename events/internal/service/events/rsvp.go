@@ -0,0 +1,124 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/utils/cursor"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"log/slog"
+)
+
+// SetRsvp меняет статус пользователя и возвращает итоговый: при нехватке мест "going" становится "waitlisted".
+// Событие блокируется на время транзакции, поэтому места не уходят в минус при одновременных запросах.
+func (s *serv) SetRsvp(ctx context.Context, eventId int64, status domain.RsvpStatus) (domain.RsvpStatus, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return "", errors.New("userId not found in context")
+	}
+	if status != domain.RsvpGoing && status != domain.RsvpInterested && status != domain.RsvpNotGoing {
+		return "", domain.ErrInvalidRsvpStatus
+	}
+
+	var result domain.RsvpStatus
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		seats, upcoming, err := s.db.LockEventSeats(ctx, eventId)
+		if err != nil {
+			return err
+		}
+		if !upcoming {
+			return domain.ErrEventFinished
+		}
+
+		// места могли добавить вручную: сначала очередь, потом новые заявки
+		seats, err = s.promoteWaitlist(ctx, eventId, seats)
+		if err != nil {
+			return err
+		}
+
+		current, err := s.db.GetRsvpStatus(ctx, userId, eventId)
+		if err != nil {
+			return err
+		}
+
+		transition := domain.NextRsvp(current, status, seats)
+		if err = s.db.SetRsvpStatus(ctx, userId, eventId, transition.Status); err != nil {
+			return err
+		}
+		if err = s.db.UpdateAttendance(ctx, eventId, transition.GoingDelta); err != nil {
+			return err
+		}
+		result = transition.Status
+
+		if transition.FreedSeat && seats != nil {
+			free := *seats - transition.GoingDelta
+			_, err = s.promoteWaitlist(ctx, eventId, &free)
+		}
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	logger.Debug("rsvp updated",
+		slog.Int64("user_id", userId),
+		slog.Int64("event_id", eventId),
+		slog.String("status", string(result)))
+	return result, nil
+}
+
+// promoteWaitlist отдаёт свободные места листу ожидания и возвращает, сколько мест осталось
+func (s *serv) promoteWaitlist(ctx context.Context, eventId int64, seats *int32) (*int32, error) {
+	if seats != nil && *seats <= 0 {
+		return seats, nil
+	}
+
+	promoted, err := s.db.PromoteWaitlist(ctx, eventId, seats)
+	if err != nil || len(promoted) == 0 {
+		return seats, err
+	}
+
+	n := int32(len(promoted))
+	if seats != nil {
+		left := *seats - n
+		seats = &left
+	}
+	if err = s.db.UpdateAttendance(ctx, eventId, n); err != nil {
+		return nil, err
+	}
+	if err = s.addRsvpPromoted(ctx, eventId, promoted); err != nil {
+		return nil, err
+	}
+
+	logger.Info("waitlist promoted", slog.Int64("event_id", eventId), slog.Int("count", len(promoted)))
+	return seats, nil
+}
+
+func (s *serv) ListRsvps(ctx context.Context, params *domain.RsvpParams) ([]*domain.Rsvp, *string, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return nil, nil, errors.New("userId not found in context")
+	}
+
+	params.Limit = pageLimit(params.Limit)
+	filters, err := cursor.Hash(params.Statuses)
+	if err != nil {
+		return nil, nil, err
+	}
+	after, err := s.decodeCursor(params.Cursor, domain.RsvpsCursorSort, filters)
+	if err != nil {
+		return nil, nil, err
+	}
+	params.After = after
+
+	rsvps, next, err := s.db.ListRsvps(ctx, userId, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token, err := s.encodeCursor(next, filters)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rsvps, token, nil
+}
@@ -2,6 +2,7 @@ package events
 
 import (
 	grpcClients "github.com/M1steryO/RelocatorEvents/events/internal/client/grpc"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/kafka"
 	"github.com/M1steryO/RelocatorEvents/events/internal/repository"
 	"github.com/M1steryO/RelocatorEvents/events/internal/service"
 	"github.com/M1steryO/platform_common/pkg/db"
@@ -9,14 +10,28 @@ import (
 
 type serv struct {
 	db         repository.EventRepository
+	outbox     repository.OutboxRepository
+	analytics  repository.AnalyticsRepository
 	txManager  db.TxManager
 	userClient grpcClients.UserServiceClient
+	views      service.ViewRecorder
+
+	cursorSecret    []byte
+	deadLetters     kafka.DeadLetterReplayer
+	calendarFeedURL string
 }
 
-func NewEventService(repo repository.EventRepository, txManager db.TxManager, userClient grpcClients.UserServiceClient) service.EventService {
+func NewEventService(repo repository.EventRepository, outboxRepo repository.OutboxRepository, analyticsRepo repository.AnalyticsRepository, txManager db.TxManager, userClient grpcClients.UserServiceClient, views service.ViewRecorder, cursorSecret []byte, deadLetters kafka.DeadLetterReplayer, calendarFeedURL string) service.EventService {
 	return &serv{
 		db:         repo,
+		outbox:     outboxRepo,
+		analytics:  analyticsRepo,
 		txManager:  txManager,
 		userClient: userClient,
+		views:      views,
+
+		cursorSecret:    cursorSecret,
+		deadLetters:     deadLetters,
+		calendarFeedURL: calendarFeedURL,
 	}
 }
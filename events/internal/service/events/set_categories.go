@@ -0,0 +1,34 @@
+package events
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+)
+
+func (s *serv) SetCategories(ctx context.Context, eventId int64, categories []string) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		event, err := s.db.Get(ctx, eventId)
+		if err != nil {
+			return err
+		}
+
+		err = s.db.DeleteEventCategories(ctx, eventId)
+		if err != nil {
+			return err
+		}
+
+		codes, err := s.resolveCategories(ctx, categories)
+		if err != nil {
+			return err
+		}
+
+		for _, category := range codes {
+			_, err = s.db.CreateEventCategory(ctx, eventId, category)
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.addEventUpdated(ctx, event, []domain.FieldChange{{Field: "categories", New: codes}})
+	})
+}
@@ -0,0 +1,44 @@
+package events
+
+import (
+	"context"
+	"errors"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"strings"
+)
+
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 50
+)
+
+func (s *serv) Suggest(ctx context.Context, query string, limit int64) ([]*domain.Suggestion, error) {
+	var suggestions []*domain.Suggestion
+
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return nil, errors.New("userId not found in context")
+	}
+
+	userCountry, err := s.userClient.GetUserCountry(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		suggestions, err = s.db.Suggest(ctx, strings.TrimSpace(query), userCountry, limit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestions, nil
+}
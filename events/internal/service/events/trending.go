@@ -0,0 +1,11 @@
+package events
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+)
+
+func (s *serv) ListTrending(ctx context.Context, params *domain.TrendingParams) ([]*domain.TrendingEvent, error) {
+	params.Limit = pageLimit(params.Limit)
+	return s.db.ListTrending(ctx, params)
+}
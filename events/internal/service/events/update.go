@@ -0,0 +1,46 @@
+package events
+
+import (
+	"context"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+)
+
+// Update заменяет событие целиком, включая расписание
+func (s *serv) Update(ctx context.Context, event *domain.Event) error {
+	event.EnsureSessions()
+	if err := event.ValidateSessions(); err != nil {
+		return err
+	}
+	return s.update(ctx, event, nil)
+}
+
+// update сохраняет событие и пишет event.updated в outbox, changes попадают в сообщение.
+// Сеансы заменяются, только если они заданы.
+func (s *serv) update(ctx context.Context, event *domain.Event, changes []domain.FieldChange) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		addressId, err := s.db.Update(ctx, event)
+		if err != nil {
+			return err
+		}
+
+		if addressId != 0 {
+			err = s.db.UpdateEventAddress(ctx, addressId, event.Address)
+		} else {
+			addressId, err = s.db.CreateEventAddress(ctx, event.Address)
+			if err == nil {
+				err = s.db.SetEventAddress(ctx, event.Id, addressId)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if event.Sessions != nil {
+			if _, err = s.db.SetEventSessions(ctx, event.Id, event.Sessions, nil); err != nil {
+				return err
+			}
+		}
+
+		return s.addEventUpdated(ctx, event, changes)
+	})
+}
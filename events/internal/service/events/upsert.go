@@ -0,0 +1,133 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"log/slog"
+	"time"
+)
+
+// upsertAttempts - сколько раз повторять Upsert, если событие с той же ссылкой создали параллельно
+const upsertAttempts = 2
+
+// Upsert создаёт событие или обновляет уже существующее с той же ссылкой.
+// Категории только добавляются: одно событие может прийти со страниц разных категорий.
+func (s *serv) Upsert(ctx context.Context, event *domain.Event, categories []string) (int64, error) {
+	var (
+		eventId int64
+		err     error
+	)
+	// между GetByLink и Create событие может создать другой воркер: Create упадёт на unique (link),
+	// транзакция откатится, и повтор найдёт событие и пойдёт по ветке обновления
+	for attempt := 0; attempt < upsertAttempts; attempt++ {
+		eventId, err = s.upsert(ctx, event, categories)
+		if !errors.Is(err, domain.ErrEventExists) {
+			break
+		}
+		logger.Info("event created concurrently, retrying upsert", slog.String("link", event.Link))
+	}
+	return eventId, err
+}
+
+func (s *serv) upsert(ctx context.Context, event *domain.Event, categories []string) (int64, error) {
+	var eventId int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		existing, err := s.db.GetByLink(ctx, event.Link)
+		if err != nil {
+			if !errors.Is(err, domain.ErrEventNotFound) {
+				return err
+			}
+
+			eventId, err = s.Create(ctx, event, categories)
+			if err != nil {
+				return err
+			}
+			logger.Info("event created", slog.Int64("id", eventId), slog.String("link", event.Link))
+			return nil
+		}
+
+		eventId = existing.Id
+		return s.updateExisting(ctx, existing, event, categories)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return eventId, nil
+}
+
+func (s *serv) updateExisting(ctx context.Context, existing, event *domain.Event, categories []string) error {
+	changes := existing.ApplyChanges(event)
+
+	addedCategories, err := s.addMissingCategories(ctx, existing.Id, categories)
+	if err != nil {
+		return err
+	}
+
+	if len(event.Sessions) > 0 {
+		now := time.Now()
+		changed, err := s.db.SetEventSessions(ctx, existing.Id, event.Sessions, &now)
+		if err != nil {
+			return err
+		}
+		if changed > 0 {
+			changes = append(changes, domain.FieldChange{Field: "sessions", New: len(event.Sessions)})
+		}
+	}
+
+	if len(changes) == 0 && len(addedCategories) == 0 {
+		logger.Debug("event unchanged", slog.Int64("id", existing.Id), slog.String("link", event.Link))
+		return nil
+	}
+
+	if len(addedCategories) > 0 {
+		changes = append(changes, domain.FieldChange{Field: "categories", New: addedCategories})
+	}
+
+	if err = s.update(ctx, existing, changes); err != nil {
+		return err
+	}
+
+	logger.Info("event updated",
+		slog.Int64("id", existing.Id),
+		slog.String("link", event.Link),
+		slog.Any("changes", changes),
+		slog.Any("added_categories", addedCategories),
+	)
+	return nil
+}
+
+func (s *serv) addMissingCategories(ctx context.Context, eventId int64, categories []string) ([]string, error) {
+	codes, err := s.resolveCategories(ctx, categories)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.db.GetEventCategories(ctx, eventId)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]struct{}, len(current))
+	for _, c := range current {
+		known[c.Code] = struct{}{}
+	}
+
+	var added []string
+	for _, code := range codes {
+		if _, ok := known[code]; ok {
+			continue
+		}
+		linked, err := s.db.CreateEventCategory(ctx, eventId, code)
+		if err != nil {
+			return nil, err
+		}
+		known[code] = struct{}{}
+		if linked {
+			added = append(added, code)
+		}
+	}
+	return added, nil
+}
@@ -0,0 +1,148 @@
+package events
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/domain/outbox"
+	"log/slog"
+)
+
+// UpsertBatch - Upsert для пачки событий в одной транзакции. Новые события, их адреса и категории
+// вставляются многострочными insert, уже известные обновляются по одному.
+// Возвращает id в порядке items.
+func (s *serv) UpsertBatch(ctx context.Context, items []*domain.UpsertItem) ([]int64, error) {
+	ids := make([]int64, len(items))
+	var createdCount, updatedCount int
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		links := make([]string, 0, len(items))
+		allCategories := make([]string, 0, len(items))
+		for _, item := range items {
+			links = append(links, item.Event.Link)
+			allCategories = append(allCategories, item.Categories...)
+		}
+
+		found, err := s.db.GetByLinks(ctx, links)
+		if err != nil {
+			return err
+		}
+		existing := make(map[string]*domain.Event, len(found))
+		for _, e := range found {
+			existing[e.Link] = e
+		}
+
+		resolved, err := s.resolveCategoryCodes(ctx, allCategories)
+		if err != nil {
+			return err
+		}
+
+		var (
+			created    []*domain.Event
+			categories [][]string
+			createdIdx = make(map[string]int)
+			itemIdx    = make([]int, len(items)) // индекс в created для новых событий
+		)
+		for i, item := range items {
+			if e, ok := existing[item.Event.Link]; ok {
+				if err = s.updateExisting(ctx, e, item.Event, item.Categories); err != nil {
+					return err
+				}
+				ids[i] = e.Id
+				itemIdx[i] = -1
+				updatedCount++
+				continue
+			}
+
+			codes := pickCategories(resolved, item.Categories)
+			if j, ok := createdIdx[item.Event.Link]; ok {
+				// одно событие дважды в пачке: более позднее сообщение дополняет первое
+				created[j].ApplyChanges(item.Event)
+				created[j].Sessions = append(created[j].Sessions, item.Event.Sessions...)
+				created[j].EnsureSessions()
+				categories[j] = append(categories[j], codes...)
+				itemIdx[i] = j
+				continue
+			}
+
+			item.Event.EnsureSessions()
+			createdIdx[item.Event.Link] = len(created)
+			itemIdx[i] = len(created)
+			created = append(created, item.Event)
+			categories = append(categories, codes)
+		}
+
+		if len(created) == 0 {
+			return nil
+		}
+
+		addresses := make([]*domain.EventAddress, len(created))
+		for j, e := range created {
+			addresses[j] = e.Address
+		}
+		addressIds, err := s.db.CreateEventAddresses(ctx, addresses)
+		if err != nil {
+			return err
+		}
+
+		eventIds, err := s.db.CreateBatch(ctx, created, addressIds)
+		if err != nil {
+			return err
+		}
+
+		var sessionEventIds []int64
+		var sessions []*domain.EventSession
+		for j, e := range created {
+			for _, session := range e.Sessions {
+				sessionEventIds = append(sessionEventIds, eventIds[j])
+				sessions = append(sessions, session)
+			}
+		}
+		if err = s.db.CreateEventSessions(ctx, sessionEventIds, sessions); err != nil {
+			return err
+		}
+
+		var linkEventIds []int64
+		var linkCodes []string
+		for j, codes := range categories {
+			for _, code := range codes {
+				linkEventIds = append(linkEventIds, eventIds[j])
+				linkCodes = append(linkCodes, code)
+			}
+		}
+		if err = s.db.CreateEventCategories(ctx, linkEventIds, linkCodes); err != nil {
+			return err
+		}
+
+		messages := make([]*outbox.Message, 0, len(created))
+		for j, e := range created {
+			e.Id = eventIds[j]
+			msg, err := eventCreatedMessage(e, categories[j])
+			if err != nil {
+				return err
+			}
+			messages = append(messages, msg)
+		}
+		if err = s.outbox.Add(ctx, messages...); err != nil {
+			return err
+		}
+
+		for i, j := range itemIdx {
+			if j >= 0 {
+				ids[i] = eventIds[j]
+			}
+		}
+		createdCount = len(created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("events batch upserted",
+		slog.Int("items", len(items)),
+		slog.Int("created", createdCount),
+		slog.Int("updated", updatedCount),
+	)
+
+	return ids, nil
+}
@@ -0,0 +1,42 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
+)
+
+// GetViewStats возвращает дневные агрегаты просмотров за [From, To) и итог за весь диапазон
+func (s *serv) GetViewStats(ctx context.Context, params *domain.StatsParams) (*domain.Stats, error) {
+	if params.Key == "" {
+		return nil, fmt.Errorf("%w: one of event_id, city or category is required", domain.ErrInvalidStatsParams)
+	}
+	if !params.To.After(params.From) {
+		return nil, fmt.Errorf("%w: date_from is after date_to", domain.ErrInvalidStatsParams)
+	}
+	if params.To.Sub(params.From) > domain.MaxStatsRange {
+		return nil, fmt.Errorf("%w: date range is too long", domain.ErrInvalidStatsParams)
+	}
+
+	days, err := s.analytics.GetDailyStats(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &domain.DailyStats{Day: params.From}
+	for _, d := range days {
+		total.Views += d.Views
+		total.ListViews += d.ListViews
+		total.Impressions += d.Impressions
+		total.Favorites += d.Favorites
+	}
+	total.UniqueViewers, err = s.analytics.CountUniqueViewers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Stats{
+		Total: total,
+		Days:  days,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package outbox
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/kafka"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository"
+	"github.com/M1steryO/RelocatorEvents/events/internal/service"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"log/slog"
+	"time"
+)
+
+type relay struct {
+	repo      repository.OutboxRepository
+	txManager db.TxManager
+	producer  kafka.MessageProducer
+
+	topic       string
+	batchSize   int
+	interval    time.Duration
+	maxAttempts int
+	retention   time.Duration
+
+	lastPurge time.Time
+}
+
+// purgeInterval - как часто удалять отправленные сообщения старше retention
+const purgeInterval = time.Hour
+
+func NewOutboxRelay(repo repository.OutboxRepository, txManager db.TxManager, producer kafka.MessageProducer,
+	topic string, batchSize int, interval time.Duration, maxAttempts int, retention time.Duration) service.OutboxRelay {
+	return &relay{
+		repo:      repo,
+		txManager: txManager,
+		producer:  producer,
+
+		topic:       topic,
+		batchSize:   batchSize,
+		interval:    interval,
+		maxAttempts: maxAttempts,
+		retention:   retention,
+	}
+}
+
+func (r *relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := r.publishBatch(ctx)
+		if err != nil {
+			logger.Error("outbox relay error", slog.String("err", err.Error()))
+		}
+
+		// полный батч - скорее всего есть ещё, не ждём тика
+		if err == nil && published == r.batchSize {
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+
+		r.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge раз в purgeInterval удаляет отправленные сообщения старше retention
+func (r *relay) purge(ctx context.Context) {
+	if time.Since(r.lastPurge) < purgeInterval {
+		return
+	}
+	r.lastPurge = time.Now()
+
+	before := time.Now().Add(-r.retention)
+	for ctx.Err() == nil {
+		deleted, err := r.repo.PurgePublished(ctx, before, r.batchSize)
+		if err != nil {
+			logger.Error("outbox purge error", slog.String("err", err.Error()))
+			return
+		}
+		if deleted > 0 {
+			logger.Debug("outbox purged", slog.Int64("deleted", deleted))
+		}
+		if deleted < int64(r.batchSize) {
+			return
+		}
+	}
+}
+
+// publishBatch отправляет сообщения, пока блокирует их строки. Если транзакция не закоммитится
+// после отправки, сообщения уйдут повторно (at-least-once) - потребители отсеивают повторы по id конверта.
+func (r *relay) publishBatch(ctx context.Context) (int, error) {
+	var published int
+	err := r.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		messages, err := r.repo.FetchPending(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int64, 0, len(messages))
+		for _, m := range messages {
+			if pubErr := r.producer.Produce(string(m.Payload), r.topic, m.Key(), m.CreatedAt); pubErr != nil {
+				logger.Warn("failed to publish outbox message",
+					slog.Int64("id", m.Id),
+					slog.String("type", m.EventType),
+					slog.Int("attempts", m.Attempts+1),
+					slog.String("err", pubErr.Error()),
+				)
+				failed, err := r.repo.MarkFailed(ctx, m.Id, pubErr, r.maxAttempts)
+				if err != nil {
+					return err
+				}
+				if failed {
+					// сообщение больше не отправляется и не держит очередь, разбирать его вручную
+					logger.Error("outbox message failed permanently",
+						slog.Int64("id", m.Id),
+						slog.String("type", m.EventType),
+						slog.String("dedup_key", m.DedupKey),
+						slog.String("err", pubErr.Error()),
+					)
+					continue
+				}
+				// дальше не идём, чтобы не нарушить порядок сообщений одного агрегата
+				break
+			}
+			ids = append(ids, m.Id)
+		}
+
+		if err = r.repo.MarkPublished(ctx, ids); err != nil {
+			return err
+		}
+		published = len(ids)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return published, nil
+}
@@ -0,0 +1,72 @@
+package popularity
+
+import (
+	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository"
+	"github.com/M1steryO/RelocatorEvents/events/internal/service"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"log/slog"
+	"time"
+)
+
+type refresher struct {
+	repo      repository.EventRepository
+	txManager db.TxManager
+
+	interval time.Duration
+	halfLife time.Duration
+}
+
+func NewPopularityRefresher(repo repository.EventRepository, txManager db.TxManager,
+	interval time.Duration, halfLife time.Duration) service.PopularityRefresher {
+	return &refresher{
+		repo:      repo,
+		txManager: txManager,
+
+		interval: interval,
+		halfLife: halfLife,
+	}
+}
+
+func (r *refresher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.refresh(ctx); err != nil {
+			logger.Error("popularity refresh error", slog.String("err", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *refresher) refresh(ctx context.Context) error {
+	started := time.Now()
+	params := &domain.PopularityParams{
+		At:       started,
+		HalfLife: r.halfLife,
+	}
+
+	var refreshed int64
+	err := r.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var err error
+		refreshed, err = r.repo.RefreshPopularity(ctx, params)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("popularity refreshed",
+		slog.Int64("events", refreshed),
+		slog.Duration("took", time.Since(started)),
+	)
+	return nil
+}
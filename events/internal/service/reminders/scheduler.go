@@ -0,0 +1,154 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	grpcClients "github.com/M1steryO/RelocatorEvents/events/internal/client/grpc"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/notifier"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domainEvents "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository"
+	"github.com/M1steryO/RelocatorEvents/events/internal/service"
+	"github.com/M1steryO/platform_common/pkg/db"
+	"log/slog"
+	"time"
+)
+
+type SchedulerOptions struct {
+	PollInterval       time.Duration
+	BatchSize          int
+	DefaultHoursBefore int32
+	MaxAttempts        int32
+	RetryBackoff       time.Duration
+	// SendingTimeout - через сколько напоминание, зависшее в sending, считается неотправленным
+	SendingTimeout time.Duration
+}
+
+type scheduler struct {
+	repo       repository.ReminderRepository
+	txManager  db.TxManager
+	notifier   notifier.Notifier
+	userClient grpcClients.UserServiceClient
+
+	opts SchedulerOptions
+}
+
+func NewReminderScheduler(repo repository.ReminderRepository, txManager db.TxManager, notifier notifier.Notifier,
+	userClient grpcClients.UserServiceClient, opts SchedulerOptions) service.ReminderScheduler {
+	return &scheduler{
+		repo:       repo,
+		txManager:  txManager,
+		notifier:   notifier,
+		userClient: userClient,
+
+		opts: opts,
+	}
+}
+
+func (s *scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.schedule(ctx); err != nil {
+			logger.Error("reminder schedule error", slog.String("err", err.Error()))
+		}
+
+		sent, err := s.sendDue(ctx)
+		if err != nil {
+			logger.Error("reminder send error", slog.String("err", err.Error()))
+		}
+
+		// полный батч - скорее всего есть ещё, не ждём тика
+		if err == nil && sent == s.opts.BatchSize {
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// schedule синхронизирует таблицу напоминаний с избранным, rsvp и настройками пользователей
+func (s *scheduler) schedule(ctx context.Context) error {
+	now := time.Now()
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if _, err := s.repo.FailStale(ctx, now.Add(-s.opts.SendingTimeout)); err != nil {
+			return err
+		}
+		if _, err := s.repo.ExpireReminders(ctx, now); err != nil {
+			return err
+		}
+		if _, err := s.repo.CancelReminders(ctx); err != nil {
+			return err
+		}
+		_, err := s.repo.ScheduleReminders(ctx, &domain.ScheduleParams{
+			Now:                now,
+			DefaultHoursBefore: s.opts.DefaultHoursBefore,
+		})
+		return err
+	})
+}
+
+func (s *scheduler) sendDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	reminders, err := s.repo.ClaimDue(ctx, now, s.opts.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, r := range reminders {
+		if err = s.send(ctx, r); err != nil {
+			logger.Error("failed to save reminder result", slog.Int64("id", r.Id), slog.String("err", err.Error()))
+		}
+	}
+	return len(reminders), nil
+}
+
+// send отправляет забранное напоминание и фиксирует результат. Если результат не удалось записать,
+// напоминание закроет FailStale. Результат пишется и при остановке сервиса, чтобы прерванная отправка повторилась
+func (s *scheduler) send(ctx context.Context, r *domain.Reminder) error {
+	now := time.Now()
+	markCtx := context.WithoutCancel(ctx)
+	sendErr := s.notifier.Notify(ctx, &notifier.Notification{
+		UserId: r.UserId,
+		Text:   r.Text(now, s.userLocation(ctx, r.UserId)),
+	})
+	if sendErr == nil {
+		return s.repo.MarkSent(markCtx, r.Id)
+	}
+
+	logger.Warn("failed to send reminder",
+		slog.Int64("id", r.Id),
+		slog.Int64("user_id", r.UserId),
+		slog.Int("attempts", int(r.Attempts)),
+		slog.String("err", sendErr.Error()),
+	)
+
+	// backoff удваивается с каждой попыткой; повтор после начала сеанса уже не нужен
+	notBefore := now.Add(s.opts.RetryBackoff << (r.Attempts - 1))
+	if errors.Is(sendErr, notifier.ErrRecipientUnavailable) ||
+		r.Attempts >= s.opts.MaxAttempts || !notBefore.Before(r.StartsAt) {
+		return s.repo.MarkFailed(markCtx, r.Id, sendErr)
+	}
+	return s.repo.MarkRetry(markCtx, r.Id, sendErr, notBefore)
+}
+
+func (s *scheduler) userLocation(ctx context.Context, userId int64) *time.Location {
+	location, err := s.userClient.GetUserLocation(ctx, userId)
+	if err != nil {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(domainEvents.ResolveTimezone(location.Country, location.City))
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,62 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
+	"github.com/M1steryO/RelocatorEvents/events/internal/repository"
+	"github.com/M1steryO/RelocatorEvents/events/internal/service"
+)
+
+type serv struct {
+	repo repository.ReminderRepository
+
+	defaultHoursBefore int32
+}
+
+func NewReminderService(repo repository.ReminderRepository, defaultHoursBefore int32) service.ReminderService {
+	return &serv{
+		repo: repo,
+
+		defaultHoursBefore: defaultHoursBefore,
+	}
+}
+
+// GetSettings возвращает настройки пользователя, а если он их не задавал - настройки по умолчанию
+func (s *serv) GetSettings(ctx context.Context) (*domain.Settings, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return nil, errors.New("userId not found in context")
+	}
+
+	settings, err := s.repo.GetSettings(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrSettingsNotFound) {
+			return &domain.Settings{
+				UserId:      userId,
+				HoursBefore: s.defaultHoursBefore,
+				Enabled:     true,
+			}, nil
+		}
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateSettings сохраняет настройки; уже запланированные напоминания перенесёт или отменит планировщик
+func (s *serv) UpdateSettings(ctx context.Context, settings *domain.Settings) (*domain.Settings, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return nil, errors.New("userId not found in context")
+	}
+
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+	settings.UserId = userId
+
+	if err := s.repo.UpsertSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
@@ -21,7 +21,15 @@ func (s *serv) Create(ctx context.Context, eventId, authorId int64, review *doma
 			return err
 		}
 
-		if err := s.eventsRepo.UpdateRating(txCtx, eventId, review.Grade); err != nil {
+		if err := s.eventsRepo.UpdateRating(txCtx, eventId, 1, review.Grade); err != nil {
+			return err
+		}
+
+		msg, err := reviewCreatedMessage(id, eventId, authorId, review)
+		if err != nil {
+			return err
+		}
+		if err := s.outboxRepo.Add(txCtx, msg); err != nil {
 			return err
 		}
 
@@ -0,0 +1,58 @@
+package reviews
+
+import (
+	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	"log/slog"
+)
+
+func (s *serv) Delete(ctx context.Context, reviewId int64) error {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return errors.New("userId not found in context")
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(txCtx context.Context) error {
+		current, err := s.reviewsRepo.GetForUpdate(txCtx, reviewId)
+		if err != nil {
+			return err
+		}
+		if current.AuthorId != userId {
+			return domain.ErrReviewForbidden
+		}
+
+		if err = s.reviewsRepo.Delete(txCtx, reviewId); err != nil {
+			return err
+		}
+
+		if err = s.eventsRepo.UpdateRating(txCtx, current.EventId, -1, -current.Grade); err != nil {
+			return err
+		}
+
+		msg, err := reviewDeletedMessage(current)
+		if err != nil {
+			return err
+		}
+		return s.outboxRepo.Add(txCtx, msg)
+	})
+	if err != nil {
+		if !errors.Is(err, domain.ErrReviewNotFound) && !errors.Is(err, domain.ErrReviewForbidden) {
+			logger.Error(
+				"failed to delete review",
+				slog.Int64("review_id", reviewId),
+				slog.Int64("author_id", userId),
+				slog.Any("err", err.Error()),
+			)
+		}
+		return err
+	}
+
+	logger.Info(
+		"review deleted",
+		slog.Int64("review_id", reviewId),
+		slog.Int64("author_id", userId),
+	)
+	return nil
+}
@@ -0,0 +1,43 @@
+package reviews
+
+import (
+	"context"
+	"errors"
+)
+
+// MarkHelpful ставит или снимает голос "полезно" текущего пользователя; повторный голос ничего не меняет
+func (s *serv) MarkHelpful(ctx context.Context, reviewId int64, helpful bool) (int32, error) {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return 0, errors.New("userId not found in context")
+	}
+
+	var count int32
+	err := s.txManager.ReadCommitted(ctx, func(txCtx context.Context) error {
+		var (
+			changed bool
+			delta   int
+			err     error
+		)
+		if helpful {
+			changed, err = s.reviewsRepo.AddHelpfulVote(txCtx, reviewId, userId)
+			delta = 1
+		} else {
+			changed, err = s.reviewsRepo.RemoveHelpfulVote(txCtx, reviewId, userId)
+			delta = -1
+		}
+		if err != nil {
+			return err
+		}
+		if !changed {
+			delta = 0
+		}
+
+		count, err = s.reviewsRepo.UpdateHelpfulCount(txCtx, reviewId, delta)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
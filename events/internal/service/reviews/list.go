@@ -2,22 +2,51 @@ package reviews
 
 import (
 	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/utils/cursor"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
 	"github.com/M1steryO/RelocatorEvents/events/internal/usecases/reviews"
 )
 
-func (s *serv) List(ctx context.Context, eventId int64) (*reviews.ListReviewsResult, error) {
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+func (s *serv) List(ctx context.Context, params *domain.ListParams) (*reviews.ListReviewsResult, error) {
 	var (
 		res reviews.ListReviewsResult
 		err error
 	)
 
+	if params.Sort == "" {
+		params.Sort = domain.SortNewest
+	}
+	if !domain.ValidSort(params.Sort) {
+		return nil, domain.ErrInvalidSort
+	}
+	params.Limit = pageLimit(params.Limit)
+	filters, err := cursor.Hash(params.Filters())
+	if err != nil {
+		return nil, err
+	}
+	params.After, err = s.decodeCursor(params.Cursor, params.Sort, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var next *domain.Cursor
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		res.Reviews, err = s.reviewsRepo.List(ctx, eventId)
+		res.Reviews, next, err = s.reviewsRepo.List(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		res.Histogram, err = s.reviewsRepo.GradeHistogram(ctx, params.EventId)
 		if err != nil {
 			return err
 		}
 
-		event, err := s.eventsRepo.Get(ctx, eventId)
+		event, err := s.eventsRepo.Get(ctx, params.EventId)
 		if err != nil {
 			return err
 		}
@@ -35,5 +64,45 @@ func (s *serv) List(ctx context.Context, eventId int64) (*reviews.ListReviewsRes
 		return nil, err
 	}
 
+	res.NextCursor, err = s.encodeCursor(next, filters)
+	if err != nil {
+		return nil, err
+	}
 	return &res, nil
 }
+
+// decodeCursor проверяет подпись курсора и то, что он выдан для той же сортировки, события и фильтров
+func (s *serv) decodeCursor(token *string, sort, filters string) (*domain.Cursor, error) {
+	if token == nil || *token == "" {
+		return nil, nil
+	}
+
+	after := &domain.Cursor{}
+	if err := cursor.Decode(*token, s.cursorSecret, after); err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+	if after.Sort != sort || after.Filters != filters {
+		return nil, domain.ErrInvalidCursor
+	}
+	return after, nil
+}
+
+func (s *serv) encodeCursor(next *domain.Cursor, filters string) (*string, error) {
+	if next == nil {
+		return nil, nil
+	}
+	next.Filters = filters
+	token, err := cursor.Encode(next, s.cursorSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func pageLimit(requested *int64) *int64 {
+	limit := int64(defaultListLimit)
+	if requested != nil && *requested > 0 {
+		limit = min(*requested, maxListLimit)
+	}
+	return &limit
+}
@@ -0,0 +1,39 @@
+package reviews
+
+import (
+	"fmt"
+	"github.com/M1steryO/RelocatorEvents/events/internal/domain/outbox"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	"time"
+)
+
+func reviewPayload(id, eventId, authorId int64, review *domain.Review) outbox.ReviewPayload {
+	payload := outbox.ReviewPayload{
+		Id:       id,
+		EventId:  eventId,
+		AuthorId: authorId,
+		Grade:    review.Grade,
+	}
+	for _, m := range review.Media {
+		if m != nil {
+			payload.MediaKeys = append(payload.MediaKeys, m.StorageKey)
+		}
+	}
+	return payload
+}
+
+func reviewCreatedMessage(id, eventId, authorId int64, review *domain.Review) (*outbox.Message, error) {
+	return outbox.NewMessage(outbox.AggregateReview, id, outbox.ReviewCreated,
+		fmt.Sprintf("%s:%d", outbox.ReviewCreated, id), reviewPayload(id, eventId, authorId, review))
+}
+
+// отзыв может правиться много раз, поэтому ключ включает момент изменения
+func reviewUpdatedMessage(id, eventId, authorId int64, review *domain.Review) (*outbox.Message, error) {
+	return outbox.NewMessage(outbox.AggregateReview, id, outbox.ReviewUpdated,
+		fmt.Sprintf("%s:%d:%d", outbox.ReviewUpdated, id, time.Now().UnixNano()), reviewPayload(id, eventId, authorId, review))
+}
+
+func reviewDeletedMessage(review *domain.Review) (*outbox.Message, error) {
+	return outbox.NewMessage(outbox.AggregateReview, review.Id, outbox.ReviewDeleted,
+		fmt.Sprintf("%s:%d", outbox.ReviewDeleted, review.Id), reviewPayload(review.Id, review.EventId, review.AuthorId, review))
+}
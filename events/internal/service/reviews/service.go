@@ -8,13 +8,19 @@ import (
 type serv struct {
 	reviewsRepo repository.ReviewRepository
 	eventsRepo  repository.EventRepository
+	outboxRepo  repository.OutboxRepository
 	txManager   db.TxManager
+
+	cursorSecret []byte
 }
 
-func NewReviewService(reviewsRepo repository.ReviewRepository, eventsRepo repository.EventRepository, tx db.TxManager) *serv {
+func NewReviewService(reviewsRepo repository.ReviewRepository, eventsRepo repository.EventRepository, outboxRepo repository.OutboxRepository, tx db.TxManager, cursorSecret []byte) *serv {
 	return &serv{
 		reviewsRepo: reviewsRepo,
 		eventsRepo:  eventsRepo,
+		outboxRepo:  outboxRepo,
 		txManager:   tx,
+
+		cursorSecret: cursorSecret,
 	}
 }
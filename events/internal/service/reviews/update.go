@@ -0,0 +1,69 @@
+package reviews
+
+import (
+	"context"
+	"errors"
+	"github.com/M1steryO/RelocatorEvents/events/internal/core/logger"
+	domain "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
+	"log/slog"
+)
+
+// Update меняет текст, оценку и вложения отзыва. Рейтинг события сдвигается на разницу оценок
+// в той же транзакции, что и правка
+func (s *serv) Update(ctx context.Context, reviewId int64, review *domain.Review) error {
+	userId, ok := ctx.Value("userId").(int64)
+	if !ok {
+		return errors.New("userId not found in context")
+	}
+
+	err := s.txManager.ReadCommitted(ctx, func(txCtx context.Context) error {
+		current, err := s.reviewsRepo.GetForUpdate(txCtx, reviewId)
+		if err != nil {
+			return err
+		}
+		if current.AuthorId != userId {
+			return domain.ErrReviewForbidden
+		}
+
+		if err = s.reviewsRepo.Update(txCtx, reviewId, review); err != nil {
+			return err
+		}
+
+		if err = s.reviewsRepo.DeleteMedia(txCtx, reviewId); err != nil {
+			return err
+		}
+		if err = s.reviewsRepo.CreateMedia(txCtx, reviewId, review.Media); err != nil {
+			return err
+		}
+
+		if delta := review.Grade - current.Grade; delta != 0 {
+			if err = s.eventsRepo.UpdateRating(txCtx, current.EventId, 0, delta); err != nil {
+				return err
+			}
+		}
+
+		msg, err := reviewUpdatedMessage(reviewId, current.EventId, userId, review)
+		if err != nil {
+			return err
+		}
+		return s.outboxRepo.Add(txCtx, msg)
+	})
+	if err != nil {
+		if !errors.Is(err, domain.ErrReviewNotFound) && !errors.Is(err, domain.ErrReviewForbidden) {
+			logger.Error(
+				"failed to update review",
+				slog.Int64("review_id", reviewId),
+				slog.Int64("author_id", userId),
+				slog.Any("err", err.Error()),
+			)
+		}
+		return err
+	}
+
+	logger.Info(
+		"review updated",
+		slog.Int64("review_id", reviewId),
+		slog.Int64("author_id", userId),
+	)
+	return nil
+}
@@ -2,18 +2,76 @@ package service
 
 import (
 	"context"
+	"github.com/M1steryO/RelocatorEvents/events/internal/client/kafka"
+	domainAnalytics "github.com/M1steryO/RelocatorEvents/events/internal/domain/analytics"
 	domainEvents "github.com/M1steryO/RelocatorEvents/events/internal/domain/events"
+	domainReminders "github.com/M1steryO/RelocatorEvents/events/internal/domain/reminders"
 	domainReviews "github.com/M1steryO/RelocatorEvents/events/internal/domain/reviews"
 	"github.com/M1steryO/RelocatorEvents/events/internal/usecases/reviews"
+	"time"
 )
 
 type EventService interface {
-	Get(ctx context.Context, id int64) (*domainEvents.Event, error)
-	Create(ctx context.Context, event *domainEvents.Event, category string) (int64, error)
+	Get(ctx context.Context, id int64, source domainAnalytics.ViewSource) (*domainEvents.Event, error)
+	Create(ctx context.Context, event *domainEvents.Event, categories []string) (int64, error)
+	Upsert(ctx context.Context, event *domainEvents.Event, categories []string) (int64, error)
+	UpsertBatch(ctx context.Context, items []*domainEvents.UpsertItem) ([]int64, error)
+	Update(ctx context.Context, event *domainEvents.Event) error
+	Delete(ctx context.Context, id int64) error
+	SetCategories(ctx context.Context, eventId int64, categories []string) error
+	ListCategoryAliases(ctx context.Context) ([]*domainEvents.CategoryAlias, error)
+	SetCategoryAlias(ctx context.Context, alias string, categoryCode string) error
+	DeleteCategoryAlias(ctx context.Context, alias string) error
+	AddFavorite(ctx context.Context, eventId int64) error
+	RemoveFavorite(ctx context.Context, eventId int64) error
+	ListFavorites(ctx context.Context, params *domainEvents.FavoritesParams) (*domainEvents.EventsList, error)
+	SetRsvp(ctx context.Context, eventId int64, status domainEvents.RsvpStatus) (domainEvents.RsvpStatus, error)
+	ListRsvps(ctx context.Context, params *domainEvents.RsvpParams) ([]*domainEvents.Rsvp, *string, error)
+	ListCurrencyRates(ctx context.Context) ([]*domainEvents.CurrencyRate, error)
+	ImportCurrencyRates(ctx context.Context, file string) (int64, error)
+	ListTrending(ctx context.Context, params *domainEvents.TrendingParams) ([]*domainEvents.TrendingEvent, error)
+	GetViewStats(ctx context.Context, params *domainAnalytics.StatsParams) (*domainAnalytics.Stats, error)
+	ExportEventIcs(ctx context.Context, id int64) ([]byte, error)
+	GetCalendarFeed(ctx context.Context) (*domainEvents.CalendarFeed, error)
+	ResetCalendarFeed(ctx context.Context) (*domainEvents.CalendarFeed, error)
+	GetCalendarFeedIcs(ctx context.Context, token string) ([]byte, error)
 	GetList(ctx context.Context, params *domainEvents.SearchParams) (*domainEvents.EventsList, error)
+	GetMap(ctx context.Context, params *domainEvents.SearchParams, viewport *domainEvents.MapViewport) ([]*domainEvents.MapCluster, error)
+	Suggest(ctx context.Context, query string, limit int64) ([]*domainEvents.Suggestion, error)
+	ReplayDeadLetters(ctx context.Context, limit int64) (*kafka.ReplayResult, error)
 }
 
 type ReviewService interface {
 	Create(ctx context.Context, eventId, authorId int64, review *domainReviews.Review) (int64, error)
-	List(ctx context.Context, eventId int64) (*reviews.ListReviewsResult, error)
+	List(ctx context.Context, params *domainReviews.ListParams) (*reviews.ListReviewsResult, error)
+	Update(ctx context.Context, reviewId int64, review *domainReviews.Review) error
+	Delete(ctx context.Context, reviewId int64) error
+	MarkHelpful(ctx context.Context, reviewId int64, helpful bool) (int32, error)
+}
+
+// OutboxRelay публикует сообщения из outbox в kafka, пока не отменён ctx
+type OutboxRelay interface {
+	Run(ctx context.Context) error
+}
+
+// PopularityRefresher периодически пересчитывает популярность событий, пока не отменён ctx
+type PopularityRefresher interface {
+	Run(ctx context.Context) error
+}
+
+// ViewRecorder асинхронно пишет просмотры и показы событий и пересчитывает дневные агрегаты, пока не отменён ctx
+type ViewRecorder interface {
+	RecordView(view *domainAnalytics.View)
+	RecordImpressions(eventIds []int64, at time.Time)
+	Run(ctx context.Context) error
+}
+
+type ReminderService interface {
+	GetSettings(ctx context.Context) (*domainReminders.Settings, error)
+	UpdateSettings(ctx context.Context, settings *domainReminders.Settings) (*domainReminders.Settings, error)
+}
+
+// ReminderScheduler планирует напоминания о сеансах и отправляет наступившие, пока не отменён ctx
+type ReminderScheduler interface {
+	Run(ctx context.Context) error
 }
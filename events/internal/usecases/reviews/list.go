@@ -6,4 +6,6 @@ type ListReviewsResult struct {
 	Reviews      []*domainReviews.Review
 	EventRating  float32
 	ReviewsCount int32
+	NextCursor   *string
+	Histogram    domainReviews.Histogram
 }
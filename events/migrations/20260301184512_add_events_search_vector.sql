@@ -0,0 +1,106 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table events
+    add column search_vector tsvector;
+
+create or replace function events_build_search_vector(p_id bigint, p_title text, p_description text, p_address_id bigint)
+    returns tsvector
+    language sql
+    stable
+as
+$$
+select setweight(to_tsvector('russian', coalesce(p_title, '')), 'A') ||
+       setweight(to_tsvector('english', coalesce(p_title, '')), 'A') ||
+       setweight(to_tsvector('russian', coalesce(ea.venue_name, '')), 'B') ||
+       setweight(to_tsvector('english', coalesce(ea.venue_name, '')), 'B') ||
+       setweight(to_tsvector('russian', coalesce(cats.titles, '')), 'C') ||
+       setweight(to_tsvector('english', coalesce(cats.titles, '')), 'C') ||
+       setweight(to_tsvector('russian', coalesce(p_description, '')), 'D') ||
+       setweight(to_tsvector('english', coalesce(p_description, '')), 'D')
+from (select 1) s
+         left join event_address ea on ea.id = p_address_id
+         left join lateral (select string_agg(c.title, ' ') as titles
+                            from event_categories ec
+                                     join categories c on c.id = ec.category_id
+                            where ec.event_id = p_id) cats on true
+$$;
+
+create or replace function events_search_vector_trigger() returns trigger
+    language plpgsql
+as
+$$
+begin
+    new.search_vector := events_build_search_vector(new.id, new.title, new.description, new.address_id);
+    return new;
+end
+$$;
+
+create trigger events_search_vector_update
+    before insert or update of title, description, address_id
+    on events
+    for each row
+execute function events_search_vector_trigger();
+
+create or replace function event_address_search_vector_trigger() returns trigger
+    language plpgsql
+as
+$$
+begin
+    update events e
+    set search_vector = events_build_search_vector(e.id, e.title, e.description, e.address_id)
+    where e.address_id = new.id;
+    return null;
+end
+$$;
+
+create trigger event_address_search_vector_update
+    after update of venue_name
+    on event_address
+    for each row
+execute function event_address_search_vector_trigger();
+
+create or replace function event_categories_search_vector_trigger() returns trigger
+    language plpgsql
+as
+$$
+declare
+    v_event_id bigint;
+begin
+    if tg_op = 'DELETE' then
+        v_event_id := old.event_id;
+    else
+        v_event_id := new.event_id;
+    end if;
+
+    update events e
+    set search_vector = events_build_search_vector(e.id, e.title, e.description, e.address_id)
+    where e.id = v_event_id;
+    return null;
+end
+$$;
+
+create trigger event_categories_search_vector_update
+    after insert or delete
+    on event_categories
+    for each row
+execute function event_categories_search_vector_trigger();
+
+update events e
+set search_vector = events_build_search_vector(e.id, e.title, e.description, e.address_id);
+
+create index events_search_vector_idx on events using gin (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists events_search_vector_idx;
+drop trigger if exists event_categories_search_vector_update on event_categories;
+drop trigger if exists event_address_search_vector_update on event_address;
+drop trigger if exists events_search_vector_update on events;
+drop function if exists event_categories_search_vector_trigger();
+drop function if exists event_address_search_vector_trigger();
+drop function if exists events_search_vector_trigger();
+drop function if exists events_build_search_vector(bigint, text, text, bigint);
+alter table events
+    drop column search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+create extension if not exists pg_trgm;
+
+create index events_title_trgm_idx on events using gin (title gin_trgm_ops);
+create index event_address_venue_name_trgm_idx on event_address using gin (venue_name gin_trgm_ops);
+create index event_address_city_trgm_idx on event_address using gin (city gin_trgm_ops);
+create index categories_title_trgm_idx on categories using gin (title gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists categories_title_trgm_idx;
+drop index if exists event_address_city_trgm_idx;
+drop index if exists event_address_venue_name_trgm_idx;
+drop index if exists events_title_trgm_idx;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+create extension if not exists cube;
+create extension if not exists earthdistance;
+
+create index event_address_earth_idx on event_address
+    using gist (ll_to_earth(latitude::float8, longitude::float8))
+    where latitude is not null and longitude is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists event_address_earth_idx;
+-- +goose StatementEnd
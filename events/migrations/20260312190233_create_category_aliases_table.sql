@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create table category_aliases
+(
+    alias       varchar(255) primary key,
+    category_id bigint references categories (id) on delete cascade not null,
+
+    created_at  timestamptz default now()                            not null
+);
+
+-- категории, которые присылает парсер (yolo.ge)
+insert into category_aliases (alias, category_id)
+select a.alias, c.id
+from (values ('theater', 'theatre'),
+             ('festivals', 'parties'),
+             ('gastronomic', 'cooking'),
+             ('cafe', 'cooking'),
+             ('exhibition', 'art'),
+             ('kids', 'education'),
+             ('nightlife', 'parties'),
+             ('sports', 'sport'),
+             ('movies', 'cinema')) as a(alias, code)
+         join categories c on c.code = a.code;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists category_aliases;
+-- +goose StatementEnd
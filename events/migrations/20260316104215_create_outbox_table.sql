@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+create table outbox
+(
+    id             bigserial primary key,
+    aggregate_type varchar(50)  not null,
+    aggregate_id   bigint       not null,
+    event_type     varchar(100) not null,
+    dedup_key      varchar(255) not null unique,
+    payload        jsonb        not null,
+
+    attempts       int          not null default 0,
+    last_error     text,
+
+    created_at     timestamptz  not null default now(),
+    published_at   timestamptz
+);
+
+create index outbox_pending_idx on outbox (id) where published_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table outbox;
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+create table event_sessions
+(
+    id              bigserial primary key,
+    event_id        bigint      not null references events (id) on delete cascade,
+
+    starts_at       timestamptz not null,
+    ends_at         timestamptz,
+    min_price       int,
+    currency        varchar(3),
+    seats_available int,
+
+    created_at      timestamptz not null default now(),
+    updated_at      timestamptz,
+
+    unique (event_id, starts_at),
+    check (ends_at is null or ends_at > starts_at)
+);
+
+create index event_sessions_starts_at_idx on event_sessions (starts_at, event_id);
+
+insert into event_sessions (event_id, starts_at, min_price, currency, seats_available)
+select id, starts_at, min_price, currency, seats_available
+from events;
+
+-- events.starts_at и events.min_price остаются денормализованными: первый сеанс и минимальная цена,
+-- по ним работают сортировка, курсоры и фильтр по цене
+create function event_sessions_sync_event() returns trigger as
+$$
+declare
+    target_id bigint;
+begin
+    if tg_op = 'DELETE' then
+        target_id := old.event_id;
+    else
+        target_id := new.event_id;
+    end if;
+
+    update events e
+    set starts_at = s.starts_at,
+        min_price = coalesce(s.min_price, e.min_price)
+    from (select min(starts_at) as starts_at, min(min_price) as min_price
+          from event_sessions
+          where event_id = target_id) s
+    where e.id = target_id
+      and s.starts_at is not null
+      and (e.starts_at, e.min_price) is distinct from (s.starts_at, coalesce(s.min_price, e.min_price));
+
+    return null;
+end;
+$$ language plpgsql;
+
+create trigger event_sessions_sync_event
+    after insert or update or delete
+    on event_sessions
+    for each row
+execute function event_sessions_sync_event();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop trigger event_sessions_sync_event on event_sessions;
+drop function event_sessions_sync_event();
+drop table event_sessions;
+-- +goose StatementEnd
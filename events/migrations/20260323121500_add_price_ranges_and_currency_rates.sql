@@ -0,0 +1,119 @@
+-- +goose Up
+-- +goose StatementBegin
+-- цены хранятся в минорных единицах (1/100 валюты): 12.50 GEL -> 1250
+alter table events
+    alter column min_price type bigint using min_price::bigint * 100,
+    add column max_price bigint,
+    add column is_free   boolean not null default false,
+    alter column currency drop default;
+
+-- раньше парсер записывал 0 и для бесплатных событий, и для событий без цены - отличить их нельзя,
+-- поэтому цена считается неизвестной, а is_free выставится, когда парсер пришлёт событие с явным флагом
+update events
+set min_price = null
+where min_price = 0;
+
+alter table event_sessions
+    alter column min_price type bigint using min_price::bigint * 100,
+    add column max_price bigint;
+
+update event_sessions
+set min_price = null
+where min_price = 0;
+
+-- rate - сколько единиц валюты дают за 1 USD
+create table currency_rates
+(
+    currency   varchar(3) primary key,
+    rate       numeric(20, 8) not null check (rate > 0),
+
+    updated_at timestamptz    not null default now()
+);
+
+insert into currency_rates (currency, rate)
+values ('USD', 1);
+
+-- convert_price переводит сумму между валютами, null - если курс одной из валют не загружен
+create function convert_price(amount bigint, from_currency varchar, to_currency varchar) returns bigint as
+$$
+select case
+           when amount is null then null
+           when upper(from_currency) = upper(to_currency) then amount
+           else (select round(amount * t.rate / f.rate)::bigint
+                 from currency_rates f,
+                      currency_rates t
+                 where f.currency = upper(from_currency)
+                   and t.currency = upper(to_currency))
+           end
+$$ language sql stable;
+
+create or replace function event_sessions_sync_event() returns trigger as
+$$
+declare
+    target_id bigint;
+begin
+    if tg_op = 'DELETE' then
+        target_id := old.event_id;
+    else
+        target_id := new.event_id;
+    end if;
+
+    update events e
+    set starts_at = s.starts_at,
+        min_price = coalesce(s.min_price, e.min_price),
+        max_price = coalesce(s.max_price, e.max_price)
+    from (select min(starts_at)                       as starts_at,
+                 min(min_price)                       as min_price,
+                 max(coalesce(max_price, min_price)) as max_price
+          from event_sessions
+          where event_id = target_id) s
+    where e.id = target_id
+      and s.starts_at is not null
+      and (e.starts_at, e.min_price, e.max_price) is distinct from
+          (s.starts_at, coalesce(s.min_price, e.min_price), coalesce(s.max_price, e.max_price));
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+create or replace function event_sessions_sync_event() returns trigger as
+$$
+declare
+    target_id bigint;
+begin
+    if tg_op = 'DELETE' then
+        target_id := old.event_id;
+    else
+        target_id := new.event_id;
+    end if;
+
+    update events e
+    set starts_at = s.starts_at,
+        min_price = coalesce(s.min_price, e.min_price)
+    from (select min(starts_at) as starts_at, min(min_price) as min_price
+          from event_sessions
+          where event_id = target_id) s
+    where e.id = target_id
+      and s.starts_at is not null
+      and (e.starts_at, e.min_price) is distinct from (s.starts_at, coalesce(s.min_price, e.min_price));
+
+    return null;
+end;
+$$ language plpgsql;
+
+drop function convert_price(bigint, varchar, varchar);
+drop table currency_rates;
+
+alter table event_sessions
+    drop column max_price,
+    alter column min_price type int using (min_price / 100)::int;
+
+alter table events
+    drop column is_free,
+    drop column max_price,
+    alter column min_price type int using (min_price / 100)::int,
+    alter column currency set default 'USD';
+-- +goose StatementEnd
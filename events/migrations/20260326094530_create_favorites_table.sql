@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+create table favorites
+(
+    user_id    bigint                    not null,
+    event_id   bigint references events (id) on delete cascade not null,
+
+    created_at timestamptz default now() not null,
+
+    primary key (user_id, event_id)
+);
+
+create index favorites_user_created_idx on favorites (user_id, created_at desc, event_id desc);
+create index favorites_event_id_idx on favorites (event_id);
+
+alter table events
+    add column favorites_count int not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table events
+    drop column favorites_count;
+
+drop table if exists favorites;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+create type rsvp_status as enum ('going', 'interested', 'not_going', 'waitlisted');
+
+create table rsvps
+(
+    user_id    bigint                    not null,
+    event_id   bigint references events (id) on delete cascade not null,
+    status     rsvp_status               not null,
+
+    created_at timestamptz default now() not null,
+    -- время последней смены статуса, по нему же идёт очередь листа ожидания
+    updated_at timestamptz default now() not null,
+
+    primary key (user_id, event_id)
+);
+
+create index rsvps_waitlist_idx on rsvps (event_id, updated_at, user_id) where status = 'waitlisted';
+create index rsvps_user_status_idx on rsvps (user_id, status);
+
+-- seats_available - оставшиеся места, null - без ограничений
+alter table events
+    add column going_count int not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table events
+    drop column going_count;
+
+drop table if exists rsvps;
+drop type if exists rsvp_status;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- просмотры карточек событий, сигнал для популярности
+create table event_views
+(
+    id        bigserial primary key,
+    event_id  bigint      not null references events (id) on delete cascade,
+    user_id   bigint      not null,
+    viewed_at timestamptz not null default now()
+);
+
+create index event_views_viewed_at_idx on event_views (viewed_at);
+
+-- event_popularity пересчитывается целиком фоновой задачей.
+-- score - сумма весов сигналов с экспоненциальным затуханием по возрасту,
+-- week_score и счётчики - сигналы за последние 7 дней без затухания
+create table event_popularity
+(
+    event_id     bigint primary key references events (id) on delete cascade,
+    score        float8      not null,
+    week_score   float8      not null,
+    views        int         not null default 0,
+    favorites    int         not null default 0,
+    rsvps        int         not null default 0,
+    reviews      int         not null default 0,
+
+    refreshed_at timestamptz not null
+);
+
+create index event_popularity_week_score_idx on event_popularity (week_score desc);
+create index favorites_created_at_idx on favorites (created_at);
+create index rsvps_updated_at_idx on rsvps (updated_at);
+create index reviews_created_at_idx on reviews (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index reviews_created_at_idx;
+drop index rsvps_updated_at_idx;
+drop index favorites_created_at_idx;
+drop table event_popularity;
+drop table event_views;
+-- +goose StatementEnd
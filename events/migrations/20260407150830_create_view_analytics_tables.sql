@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- source - откуда пользователь открыл событие: list, deep_link, map, favorites
+alter table event_views
+    add column source varchar(32) not null default 'unknown';
+
+create index event_views_event_id_viewed_at_idx on event_views (event_id, viewed_at);
+
+-- показы событий в списке, копятся в памяти и пишутся суммами за день
+create table event_impressions_daily
+(
+    day         date   not null,
+    event_id    bigint not null references events (id) on delete cascade,
+    impressions bigint not null default 0,
+
+    primary key (day, event_id)
+);
+
+-- дневные агрегаты по измерениям: dimension = event (key - id события), city или category (key - code)
+create table view_daily_stats
+(
+    day            date        not null,
+    dimension      varchar(16) not null,
+    key            text        not null,
+    views          int         not null default 0,
+    unique_viewers int         not null default 0,
+    list_views     int         not null default 0,
+    impressions    bigint      not null default 0,
+    favorites      int         not null default 0,
+
+    primary key (dimension, key, day)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table view_daily_stats;
+drop table event_impressions_daily;
+drop index event_views_event_id_viewed_at_idx;
+alter table event_views
+    drop column source;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- токен подписки на личный календарь: календарные клиенты не умеют авторизоваться,
+-- поэтому доступ к ленте даёт знание токена. Сброс токена отзывает старые подписки
+create table calendar_feeds
+(
+    user_id    bigint primary key,
+    token      varchar(64) not null unique,
+
+    created_at timestamptz not null default now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table calendar_feeds;
+-- +goose StatementEnd
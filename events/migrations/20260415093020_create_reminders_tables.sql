@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+create table reminder_settings
+(
+    user_id      bigint primary key,
+    hours_before int         not null check (hours_before between 1 and 168),
+    enabled      boolean     not null default true,
+
+    updated_at   timestamptz not null default now()
+);
+
+-- reminders - задания на напоминание о сеансе. Строка на пару (user_id, session_id) создаётся один раз:
+-- отправленное или начатое отправляться напоминание повторно не ставится.
+-- sending - напоминание забрано на отправку; если процесс упал до отметки, оно уходит в failed, а не повторяется
+create table reminders
+(
+    id         bigserial primary key,
+    user_id    bigint      not null,
+    event_id   bigint      not null references events (id) on delete cascade,
+    session_id bigint      not null references event_sessions (id) on delete cascade,
+    remind_at  timestamptz not null,
+    status     varchar(16) not null default 'pending'
+        check (status in ('pending', 'sending', 'sent', 'failed', 'cancelled', 'expired')),
+    attempts   int         not null default 0,
+    -- not_before - время следующей попытки после временной ошибки доставки
+    not_before timestamptz,
+    last_error text,
+    sent_at    timestamptz,
+
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now(),
+
+    unique (user_id, session_id)
+);
+
+create index reminders_pending_idx on reminders (remind_at) where status = 'pending';
+create index reminders_sending_idx on reminders (updated_at) where status = 'sending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table reminders;
+drop table reminder_settings;
+-- +goose StatementEnd
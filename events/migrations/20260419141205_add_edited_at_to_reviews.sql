@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table reviews
+    add column edited_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table reviews
+    drop column edited_at;
+-- +goose StatementEnd
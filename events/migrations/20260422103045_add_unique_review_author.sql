@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- до ограничения один пользователь мог оставить несколько отзывов: оставляем самый ранний
+-- и пересчитываем рейтинг событий по оставшимся
+delete
+from reviews r
+    using reviews earlier
+where earlier.event_id = r.event_id
+  and earlier.author_id = r.author_id
+  and earlier.id < r.id;
+
+update events e
+set reviews_count = coalesce(s.cnt, 0),
+    rating_sum    = coalesce(s.total, 0),
+    rating        = case when coalesce(s.cnt, 0) > 0 then round(s.total::numeric / s.cnt, 2) else 0 end
+from events e2
+         left join (select event_id, count(*) as cnt, sum(grade) as total
+                    from reviews
+                    group by event_id) s on s.event_id = e2.id
+where e2.id = e.id
+  and (e.reviews_count, e.rating_sum) is distinct from (coalesce(s.cnt, 0), coalesce(s.total, 0));
+
+alter table reviews
+    add constraint reviews_event_id_author_id_key unique (event_id, author_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table reviews
+    drop constraint reviews_event_id_author_id_key;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table reviews
+    add column helpful_count int not null default 0;
+
+create table review_helpful_votes
+(
+    review_id  bigint      not null references reviews (id) on delete cascade,
+    user_id    bigint      not null,
+    created_at timestamptz not null default now(),
+
+    primary key (review_id, user_id)
+);
+
+-- пагинация отзывов события по сортировкам
+create index reviews_event_created_idx on reviews (event_id, created_at desc, id desc);
+create index reviews_event_grade_idx on reviews (event_id, grade, id);
+create index reviews_event_helpful_idx on reviews (event_id, helpful_count desc, id desc);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index reviews_event_helpful_idx;
+drop index reviews_event_grade_idx;
+drop index reviews_event_created_idx;
+drop table review_helpful_votes;
+alter table reviews
+    drop column helpful_count;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- выборка точек карты по bbox
+create index event_address_lat_lon_idx on event_address (latitude, longitude)
+    where latitude is not null and longitude is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists event_address_lat_lon_idx;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- failed_at - сообщение исчерпало попытки отправки и больше не выбирается реле
+alter table outbox
+    add column failed_at timestamptz;
+
+drop index if exists outbox_pending_idx;
+create index outbox_pending_idx on outbox (id) where published_at is null and failed_at is null;
+create index outbox_published_at_idx on outbox (published_at) where published_at is not null;
+
+-- version растёт при каждом изменении события и входит в ключ дедупликации event.updated
+alter table events
+    add column version bigint not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table events
+    drop column version;
+
+drop index if exists outbox_published_at_idx;
+drop index if exists outbox_pending_idx;
+create index outbox_pending_idx on outbox (id) where published_at is null;
+
+alter table outbox
+    drop column failed_at;
+-- +goose StatementEnd
@@ -0,0 +1,75 @@
+-- +goose Up
+-- +goose StatementBegin
+-- events.starts_at - ближайший ещё не закончившийся сеанс, а если таких нет - последний сеанс
+create or replace function event_sessions_sync_event() returns trigger as
+$$
+declare
+    target_id bigint;
+begin
+    if tg_op = 'DELETE' then
+        target_id := old.event_id;
+    else
+        target_id := new.event_id;
+    end if;
+
+    update events e
+    set starts_at = s.starts_at,
+        min_price = coalesce(s.min_price, e.min_price),
+        max_price = coalesce(s.max_price, e.max_price)
+    from (select coalesce(min(starts_at) filter (where coalesce(ends_at, starts_at) > now()),
+                          max(starts_at))              as starts_at,
+                 min(min_price)                       as min_price,
+                 max(coalesce(max_price, min_price)) as max_price
+          from event_sessions
+          where event_id = target_id) s
+    where e.id = target_id
+      and s.starts_at is not null
+      and (e.starts_at, e.min_price, e.max_price) is distinct from
+          (s.starts_at, coalesce(s.min_price, e.min_price), coalesce(s.max_price, e.max_price));
+
+    return null;
+end;
+$$ language plpgsql;
+
+update events e
+set starts_at = s.starts_at
+from (select event_id,
+             coalesce(min(starts_at) filter (where coalesce(ends_at, starts_at) > now()),
+                      max(starts_at)) as starts_at
+      from event_sessions
+      group by event_id) s
+where e.id = s.event_id
+  and e.starts_at is distinct from s.starts_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+create or replace function event_sessions_sync_event() returns trigger as
+$$
+declare
+    target_id bigint;
+begin
+    if tg_op = 'DELETE' then
+        target_id := old.event_id;
+    else
+        target_id := new.event_id;
+    end if;
+
+    update events e
+    set starts_at = s.starts_at,
+        min_price = coalesce(s.min_price, e.min_price),
+        max_price = coalesce(s.max_price, e.max_price)
+    from (select min(starts_at)                       as starts_at,
+                 min(min_price)                       as min_price,
+                 max(coalesce(max_price, min_price)) as max_price
+          from event_sessions
+          where event_id = target_id) s
+    where e.id = target_id
+      and s.starts_at is not null
+      and (e.starts_at, e.min_price, e.max_price) is distinct from
+          (s.starts_at, coalesce(s.min_price, e.min_price), coalesce(s.max_price, e.max_price));
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
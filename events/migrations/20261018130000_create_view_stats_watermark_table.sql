@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- day - первый день, который агрегатор ещё пересчитывает: все дни до него в view_daily_stats окончательные
+create table view_stats_watermark
+(
+    id  boolean primary key default true check (id),
+    day date not null
+);
+
+insert into view_stats_watermark (day)
+select coalesce(least((select min((viewed_at at time zone 'UTC')::date) from event_views),
+                      (select min(day) from event_impressions_daily),
+                      (select min((created_at at time zone 'UTC')::date) from favorites)),
+                current_date - 1);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table view_stats_watermark;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- seats_total - вместимость события, её пишут только импорт и админка, null - без ограничений.
+-- Оставшиеся места не хранятся: это seats_total - going_count
+alter table events
+    add column seats_total int;
+
+update events
+set seats_total = seats_available + going_count
+where seats_available is not null;
+
+alter table events
+    drop column seats_available;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table events
+    add column seats_available int;
+
+update events
+set seats_available = greatest(seats_total - going_count, 0)
+where seats_total is not null;
+
+alter table events
+    drop column seats_total;
+-- +goose StatementEnd
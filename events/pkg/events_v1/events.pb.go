@@ -7,9 +7,12 @@
 package events_v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -24,6 +27,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VIEW_SOURCE int32
+
+const (
+	VIEW_SOURCE_view_source_unspecified VIEW_SOURCE = 0
+	VIEW_SOURCE_list                    VIEW_SOURCE = 1
+	VIEW_SOURCE_deep_link               VIEW_SOURCE = 2
+	VIEW_SOURCE_map                     VIEW_SOURCE = 3
+	VIEW_SOURCE_favorites               VIEW_SOURCE = 4
+)
+
+// Enum value maps for VIEW_SOURCE.
+var (
+	VIEW_SOURCE_name = map[int32]string{
+		0: "view_source_unspecified",
+		1: "list",
+		2: "deep_link",
+		3: "map",
+		4: "favorites",
+	}
+	VIEW_SOURCE_value = map[string]int32{
+		"view_source_unspecified": 0,
+		"list":                    1,
+		"deep_link":               2,
+		"map":                     3,
+		"favorites":               4,
+	}
+)
+
+func (x VIEW_SOURCE) Enum() *VIEW_SOURCE {
+	p := new(VIEW_SOURCE)
+	*p = x
+	return p
+}
+
+func (x VIEW_SOURCE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VIEW_SOURCE) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[0].Descriptor()
+}
+
+func (VIEW_SOURCE) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[0]
+}
+
+func (x VIEW_SOURCE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VIEW_SOURCE.Descriptor instead.
+func (VIEW_SOURCE) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{0}
+}
+
 type EVENT_TYPE int32
 
 const (
@@ -54,11 +112,11 @@ func (x EVENT_TYPE) String() string {
 }
 
 func (EVENT_TYPE) Descriptor() protoreflect.EnumDescriptor {
-	return file_events_proto_enumTypes[0].Descriptor()
+	return file_events_proto_enumTypes[1].Descriptor()
 }
 
 func (EVENT_TYPE) Type() protoreflect.EnumType {
-	return &file_events_proto_enumTypes[0]
+	return &file_events_proto_enumTypes[1]
 }
 
 func (x EVENT_TYPE) Number() protoreflect.EnumNumber {
@@ -67,12 +125,122 @@ func (x EVENT_TYPE) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EVENT_TYPE.Descriptor instead.
 func (EVENT_TYPE) EnumDescriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{0}
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+type RSVP_STATUS int32
+
+const (
+	RSVP_STATUS_rsvp_unspecified RSVP_STATUS = 0
+	RSVP_STATUS_going            RSVP_STATUS = 1
+	RSVP_STATUS_interested       RSVP_STATUS = 2
+	RSVP_STATUS_not_going        RSVP_STATUS = 3
+	// выставляется сервером, когда на "going" не хватило мест
+	RSVP_STATUS_waitlisted RSVP_STATUS = 4
+)
+
+// Enum value maps for RSVP_STATUS.
+var (
+	RSVP_STATUS_name = map[int32]string{
+		0: "rsvp_unspecified",
+		1: "going",
+		2: "interested",
+		3: "not_going",
+		4: "waitlisted",
+	}
+	RSVP_STATUS_value = map[string]int32{
+		"rsvp_unspecified": 0,
+		"going":            1,
+		"interested":       2,
+		"not_going":        3,
+		"waitlisted":       4,
+	}
+)
+
+func (x RSVP_STATUS) Enum() *RSVP_STATUS {
+	p := new(RSVP_STATUS)
+	*p = x
+	return p
+}
+
+func (x RSVP_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RSVP_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[2].Descriptor()
+}
+
+func (RSVP_STATUS) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[2]
+}
+
+func (x RSVP_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RSVP_STATUS.Descriptor instead.
+func (RSVP_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{2}
+}
+
+type SUGGESTION_TYPE int32
+
+const (
+	SUGGESTION_TYPE_event    SUGGESTION_TYPE = 0
+	SUGGESTION_TYPE_venue    SUGGESTION_TYPE = 1
+	SUGGESTION_TYPE_city     SUGGESTION_TYPE = 2
+	SUGGESTION_TYPE_category SUGGESTION_TYPE = 3
+)
+
+// Enum value maps for SUGGESTION_TYPE.
+var (
+	SUGGESTION_TYPE_name = map[int32]string{
+		0: "event",
+		1: "venue",
+		2: "city",
+		3: "category",
+	}
+	SUGGESTION_TYPE_value = map[string]int32{
+		"event":    0,
+		"venue":    1,
+		"city":     2,
+		"category": 3,
+	}
+)
+
+func (x SUGGESTION_TYPE) Enum() *SUGGESTION_TYPE {
+	p := new(SUGGESTION_TYPE)
+	*p = x
+	return p
+}
+
+func (x SUGGESTION_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SUGGESTION_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[3].Descriptor()
+}
+
+func (SUGGESTION_TYPE) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[3]
+}
+
+func (x SUGGESTION_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SUGGESTION_TYPE.Descriptor instead.
+func (SUGGESTION_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{3}
 }
 
 type GetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// откуда открыли событие, учитывается в аналитике просмотров
+	Source        VIEW_SOURCE `protobuf:"varint,2,opt,name=source,proto3,enum=events_v1.VIEW_SOURCE" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,6 +282,13 @@ func (x *GetRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetRequest) GetSource() VIEW_SOURCE {
+	if x != nil {
+		return x.Source
+	}
+	return VIEW_SOURCE_view_source_unspecified
+}
+
 type GetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -278,15 +453,31 @@ type Event struct {
 	MinAge         *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=min_age,proto3" json:"min_age,omitempty"`
 	SeatsAvailable *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=seats_available,proto3" json:"seats_available,omitempty"`
 	EventType      EVENT_TYPE              `protobuf:"varint,10,opt,name=eventType,json=event_type,proto3,enum=events_v1.EVENT_TYPE" json:"eventType,omitempty"`
-	MinPrice       *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=min_price,proto3" json:"min_price,omitempty"`
-	StartsAt       *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
-	ImageUrl       *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=image_url,proto3" json:"image_url,omitempty"`
-	Address        *EventAddress           `protobuf:"bytes,14,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	CreatedAt      *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp  `protobuf:"bytes,16,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
-	Currency       *wrapperspb.StringValue `protobuf:"bytes,17,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// цены в целых единицах currency: min_price округлена вниз, max_price вверх
+	MinPrice  *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	StartsAt  *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
+	ImageUrl  *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=image_url,proto3" json:"image_url,omitempty"`
+	Address   *EventAddress           `protobuf:"bytes,14,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	CreatedAt *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp  `protobuf:"bytes,16,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	Currency  *wrapperspb.StringValue `protobuf:"bytes,17,opt,name=currency,proto3" json:"currency,omitempty"`
+	// фрагмент с подсветкой совпадений, заполняется при поиске по q
+	Highlight *wrapperspb.StringValue `protobuf:"bytes,18,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	// расстояние до точки lat/lon из запроса
+	DistanceM *wrapperspb.DoubleValue `protobuf:"bytes,19,opt,name=distance_m,proto3" json:"distance_m,omitempty"`
+	// сеансы события, заполняются в GetEvent
+	Sessions       []*EventSession        `protobuf:"bytes,20,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	MaxPrice       *wrapperspb.Int32Value `protobuf:"bytes,21,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	IsFree         bool                   `protobuf:"varint,22,opt,name=is_free,proto3" json:"is_free,omitempty"`
+	FavoritesCount int32                  `protobuf:"varint,23,opt,name=favorites_count,proto3" json:"favorites_count,omitempty"`
+	// событие в избранном у текущего пользователя
+	IsFavorite bool  `protobuf:"varint,24,opt,name=is_favorite,proto3" json:"is_favorite,omitempty"`
+	GoingCount int32 `protobuf:"varint,25,opt,name=going_count,proto3" json:"going_count,omitempty"`
+	// заполняются при sort=recommended: оценка от 0 до 1 и причины, например "matches: music"
+	RecommendationScore   *wrapperspb.DoubleValue `protobuf:"bytes,26,opt,name=recommendation_score,proto3" json:"recommendation_score,omitempty"`
+	RecommendationReasons []string                `protobuf:"bytes,27,rep,name=recommendation_reasons,proto3" json:"recommendation_reasons,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
@@ -438,160 +629,194 @@ func (x *Event) GetCurrency() *wrapperspb.StringValue {
 	return nil
 }
 
-type ListEventsRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Q             *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
-	Sort          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
-	City          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	District      *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=district,proto3" json:"district,omitempty"`
-	MinPrice      *wrapperspb.Int32Value  `protobuf:"bytes,5,opt,name=min_price,proto3" json:"min_price,omitempty"`
-	MaxPrice      *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=max_price,proto3" json:"max_price,omitempty"`
-	EventDate     *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=event_date,proto3" json:"event_date,omitempty"`
-	EventType     *EVENT_TYPE             `protobuf:"varint,8,opt,name=event_type,proto3,enum=events_v1.EVENT_TYPE,oneof" json:"event_type,omitempty"`
-	Category      []string                `protobuf:"bytes,9,rep,name=category,proto3" json:"category,omitempty"`
-	Limit         *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=limit,proto3" json:"limit,omitempty"`
-	LastId        *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=last_id,proto3" json:"last_id,omitempty"`
-	Offset        *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Event) GetHighlight() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Highlight
+	}
+	return nil
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
-	mi := &file_events_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Event) GetDistanceM() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.DistanceM
+	}
+	return nil
 }
 
-func (x *ListEventsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Event) GetSessions() []*EventSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (x *Event) GetMaxPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[4]
+func (x *Event) GetIsFree() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IsFree
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{4}
+func (x *Event) GetFavoritesCount() int32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
 }
 
-func (x *ListEventsRequest) GetQ() *wrapperspb.StringValue {
+func (x *Event) GetIsFavorite() bool {
 	if x != nil {
-		return x.Q
+		return x.IsFavorite
 	}
-	return nil
+	return false
 }
 
-func (x *ListEventsRequest) GetSort() *wrapperspb.StringValue {
+func (x *Event) GetGoingCount() int32 {
 	if x != nil {
-		return x.Sort
+		return x.GoingCount
 	}
-	return nil
+	return 0
 }
 
-func (x *ListEventsRequest) GetCity() *wrapperspb.StringValue {
+func (x *Event) GetRecommendationScore() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.City
+		return x.RecommendationScore
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetDistrict() *wrapperspb.StringValue {
+func (x *Event) GetRecommendationReasons() []string {
 	if x != nil {
-		return x.District
+		return x.RecommendationReasons
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetMinPrice() *wrapperspb.Int32Value {
+type EventSession struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Id             int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartsAt       *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=ends_at,proto3" json:"ends_at,omitempty"`
+	MinPrice       *wrapperspb.Int32Value  `protobuf:"bytes,4,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	Currency       *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	SeatsAvailable *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=seats_available,proto3" json:"seats_available,omitempty"`
+	MaxPrice       *wrapperspb.Int32Value  `protobuf:"bytes,7,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EventSession) Reset() {
+	*x = EventSession{}
+	mi := &file_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSession) ProtoMessage() {}
+
+func (x *EventSession) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[4]
 	if x != nil {
-		return x.MinPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListEventsRequest) GetMaxPrice() *wrapperspb.Int32Value {
+// Deprecated: Use EventSession.ProtoReflect.Descriptor instead.
+func (*EventSession) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventSession) GetId() int64 {
 	if x != nil {
-		return x.MaxPrice
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *ListEventsRequest) GetEventDate() *wrapperspb.StringValue {
+func (x *EventSession) GetStartsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EventDate
+		return x.StartsAt
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetEventType() EVENT_TYPE {
-	if x != nil && x.EventType != nil {
-		return *x.EventType
+func (x *EventSession) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
 	}
-	return EVENT_TYPE_offline
+	return nil
 }
 
-func (x *ListEventsRequest) GetCategory() []string {
+func (x *EventSession) GetMinPrice() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.Category
+		return x.MinPrice
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetLimit() *wrapperspb.Int64Value {
+func (x *EventSession) GetCurrency() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Limit
+		return x.Currency
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetLastId() *wrapperspb.Int64Value {
+func (x *EventSession) GetSeatsAvailable() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.LastId
+		return x.SeatsAvailable
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetOffset() *wrapperspb.Int64Value {
+func (x *EventSession) GetMaxPrice() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.Offset
+		return x.MaxPrice
 	}
 	return nil
 }
 
-type EventCategory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EventSessionInfo struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	StartsAt       *timestamppb.Timestamp  `protobuf:"bytes,1,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=ends_at,proto3" json:"ends_at,omitempty"`
+	MinPrice       *wrapperspb.Int32Value  `protobuf:"bytes,3,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	Currency       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	SeatsAvailable *wrapperspb.Int32Value  `protobuf:"bytes,5,opt,name=seats_available,proto3" json:"seats_available,omitempty"`
+	MaxPrice       *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *EventCategory) Reset() {
-	*x = EventCategory{}
+func (x *EventSessionInfo) Reset() {
+	*x = EventSessionInfo{}
 	mi := &file_events_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventCategory) String() string {
+func (x *EventSessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCategory) ProtoMessage() {}
+func (*EventSessionInfo) ProtoMessage() {}
 
-func (x *EventCategory) ProtoReflect() protoreflect.Message {
+func (x *EventSessionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_events_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,49 +828,95 @@ func (x *EventCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventCategory.ProtoReflect.Descriptor instead.
-func (*EventCategory) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventSessionInfo.ProtoReflect.Descriptor instead.
+func (*EventSessionInfo) Descriptor() ([]byte, []int) {
 	return file_events_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *EventCategory) GetTitle() string {
+func (x *EventSessionInfo) GetStartsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Title
+		return x.StartsAt
 	}
-	return ""
+	return nil
 }
 
-func (x *EventCategory) GetCode() string {
+func (x *EventSessionInfo) GetEndsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Code
+		return x.EndsAt
 	}
-	return ""
+	return nil
 }
 
-type FiltersValues struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MinPrice      *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=min_price,proto3" json:"min_price,omitempty"`
-	MaxPrice      *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=max_price,proto3" json:"max_price,omitempty"`
-	Cities        []string               `protobuf:"bytes,3,rep,name=cities,proto3" json:"cities,omitempty"`
-	Categories    []*EventCategory       `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+func (x *EventSessionInfo) GetMinPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *EventSessionInfo) GetCurrency() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+func (x *EventSessionInfo) GetSeatsAvailable() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return nil
+}
+
+func (x *EventSessionInfo) GetMaxPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+type ListEventsRequest struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Q     *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// distance, relevance, rating, price_asc, price_desc, new, recommended
+	Sort     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	City     *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	District *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=district,proto3" json:"district,omitempty"`
+	// цены в целых единицах валюты currency
+	MinPrice  *wrapperspb.Int32Value  `protobuf:"bytes,5,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	MaxPrice  *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	EventDate *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=event_date,proto3" json:"event_date,omitempty"`
+	EventType *EVENT_TYPE             `protobuf:"varint,8,opt,name=event_type,proto3,enum=events_v1.EVENT_TYPE,oneof" json:"event_type,omitempty"`
+	Category  []string                `protobuf:"bytes,9,rep,name=category,proto3" json:"category,omitempty"`
+	Limit     *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	Lat       *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon       *wrapperspb.DoubleValue `protobuf:"bytes,14,opt,name=lon,proto3" json:"lon,omitempty"`
+	RadiusKm  *wrapperspb.DoubleValue `protobuf:"bytes,15,opt,name=radius_km,proto3" json:"radius_km,omitempty"`
+	// next_cursor из предыдущего ответа
+	Cursor *wrapperspb.StringValue `protobuf:"bytes,16,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// диапазон дат в формате YYYY-MM-DD, date_to включительно
+	DateFrom *wrapperspb.StringValue `protobuf:"bytes,17,opt,name=date_from,proto3" json:"date_from,omitempty"`
+	DateTo   *wrapperspb.StringValue `protobuf:"bytes,18,opt,name=date_to,proto3" json:"date_to,omitempty"`
+	// валюта фильтра по цене, по умолчанию - валюта страны пользователя
+	Currency      *wrapperspb.StringValue `protobuf:"bytes,19,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FiltersValues) Reset() {
-	*x = FiltersValues{}
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
 	mi := &file_events_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FiltersValues) String() string {
+func (x *ListEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FiltersValues) ProtoMessage() {}
+func (*ListEventsRequest) ProtoMessage() {}
 
-func (x *FiltersValues) ProtoReflect() protoreflect.Message {
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_events_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -657,62 +928,2559 @@ func (x *FiltersValues) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FiltersValues.ProtoReflect.Descriptor instead.
-func (*FiltersValues) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
 	return file_events_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *FiltersValues) GetMinPrice() *wrapperspb.Int32Value {
+func (x *ListEventsRequest) GetQ() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Q
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetSort() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetCity() *wrapperspb.StringValue {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetDistrict() *wrapperspb.StringValue {
+	if x != nil {
+		return x.District
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetMinPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetMaxPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetEventDate() *wrapperspb.StringValue {
+	if x != nil {
+		return x.EventDate
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetEventType() EVENT_TYPE {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return EVENT_TYPE_offline
+}
+
+func (x *ListEventsRequest) GetCategory() []string {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetLimit() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetLat() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Lat
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetLon() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Lon
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetRadiusKm() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetDateFrom() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetDateTo() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetCurrency() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+type EventCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventCategory) Reset() {
+	*x = EventCategory{}
+	mi := &file_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCategory) ProtoMessage() {}
+
+func (x *EventCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventCategory.ProtoReflect.Descriptor instead.
+func (*EventCategory) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventCategory) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventCategory) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type FiltersValues struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	MinPrice   *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	MaxPrice   *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	Cities     []string               `protobuf:"bytes,3,rep,name=cities,proto3" json:"cities,omitempty"`
+	Categories []*EventCategory       `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	// валюта min_price и max_price
+	Currency      string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FiltersValues) Reset() {
+	*x = FiltersValues{}
+	mi := &file_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FiltersValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiltersValues) ProtoMessage() {}
+
+func (x *FiltersValues) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiltersValues.ProtoReflect.Descriptor instead.
+func (*FiltersValues) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FiltersValues) GetMinPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *FiltersValues) GetMaxPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *FiltersValues) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *FiltersValues) GetCategories() []*EventCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *FiltersValues) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Data          []*Event                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Filters       *FiltersValues          `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	NextCursor    *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListEventsResponse) GetData() []*Event {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetFilters() *FiltersValues {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+type EventInfo struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Title       string                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Link        string                  `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	MinAge      *wrapperspb.Int32Value  `protobuf:"bytes,4,opt,name=min_age,proto3" json:"min_age,omitempty"`
+	// вместимость события; оставшиеся места считаются из неё и числа "going"
+	SeatsAvailable *wrapperspb.Int32Value  `protobuf:"bytes,5,opt,name=seats_available,proto3" json:"seats_available,omitempty"`
+	EventType      EVENT_TYPE              `protobuf:"varint,6,opt,name=event_type,proto3,enum=events_v1.EVENT_TYPE" json:"event_type,omitempty"`
+	MinPrice       *wrapperspb.Int32Value  `protobuf:"bytes,7,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	Currency       *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartsAt       *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
+	ImageUrl       *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=image_url,proto3" json:"image_url,omitempty"`
+	Address        *EventAddress           `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	// расписание; если пусто, событие получает один сеанс из starts_at
+	Sessions      []*EventSessionInfo    `protobuf:"bytes,12,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	MaxPrice      *wrapperspb.Int32Value `protobuf:"bytes,13,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	IsFree        bool                   `protobuf:"varint,14,opt,name=is_free,proto3" json:"is_free,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventInfo) Reset() {
+	*x = EventInfo{}
+	mi := &file_events_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInfo) ProtoMessage() {}
+
+func (x *EventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventInfo.ProtoReflect.Descriptor instead.
+func (*EventInfo) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventInfo) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *EventInfo) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *EventInfo) GetMinAge() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinAge
+	}
+	return nil
+}
+
+func (x *EventInfo) GetSeatsAvailable() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return nil
+}
+
+func (x *EventInfo) GetEventType() EVENT_TYPE {
+	if x != nil {
+		return x.EventType
+	}
+	return EVENT_TYPE_offline
+}
+
+func (x *EventInfo) GetMinPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *EventInfo) GetCurrency() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+func (x *EventInfo) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *EventInfo) GetImageUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return nil
+}
+
+func (x *EventInfo) GetAddress() *EventAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *EventInfo) GetSessions() []*EventSessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *EventInfo) GetMaxPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *EventInfo) GetIsFree() bool {
+	if x != nil {
+		return x.IsFree
+	}
+	return false
+}
+
+type CreateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EventInfo             `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Categories    []string               `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateEventRequest) GetEvent() *EventInfo {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventResponse) Reset() {
+	*x = CreateEventResponse{}
+	mi := &file_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventResponse) ProtoMessage() {}
+
+func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateEventResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         *EventInfo             `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateEventRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetEvent() *EventInfo {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type DeleteEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
+	mi := &file_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventRequest) ProtoMessage() {}
+
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteEventRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteRequest) Reset() {
+	*x = FavoriteRequest{}
+	mi := &file_events_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteRequest) ProtoMessage() {}
+
+func (x *FavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteRequest.ProtoReflect.Descriptor instead.
+func (*FavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FavoriteRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type ListFavoritesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// по умолчанию прошедшие события не показываются
+	IncludePast   bool                    `protobuf:"varint,1,opt,name=include_past,proto3" json:"include_past,omitempty"`
+	Limit         *wrapperspb.Int64Value  `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_events_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListFavoritesRequest) GetIncludePast() bool {
+	if x != nil {
+		return x.IncludePast
+	}
+	return false
+}
+
+func (x *ListFavoritesRequest) GetLimit() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *ListFavoritesRequest) GetCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Data          []*Event                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_events_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListFavoritesResponse) GetData() []*Event {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListFavoritesResponse) GetNextCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+type SetRsvpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	Status        RSVP_STATUS            `protobuf:"varint,2,opt,name=status,proto3,enum=events_v1.RSVP_STATUS" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRsvpRequest) Reset() {
+	*x = SetRsvpRequest{}
+	mi := &file_events_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRsvpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRsvpRequest) ProtoMessage() {}
+
+func (x *SetRsvpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRsvpRequest.ProtoReflect.Descriptor instead.
+func (*SetRsvpRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetRsvpRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *SetRsvpRequest) GetStatus() RSVP_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return RSVP_STATUS_rsvp_unspecified
+}
+
+type SetRsvpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        RSVP_STATUS            `protobuf:"varint,1,opt,name=status,proto3,enum=events_v1.RSVP_STATUS" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRsvpResponse) Reset() {
+	*x = SetRsvpResponse{}
+	mi := &file_events_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRsvpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRsvpResponse) ProtoMessage() {}
+
+func (x *SetRsvpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRsvpResponse.ProtoReflect.Descriptor instead.
+func (*SetRsvpResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetRsvpResponse) GetStatus() RSVP_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return RSVP_STATUS_rsvp_unspecified
+}
+
+type ListRsvpsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// пустой список - все статусы
+	Status        []RSVP_STATUS           `protobuf:"varint,1,rep,packed,name=status,proto3,enum=events_v1.RSVP_STATUS" json:"status,omitempty"`
+	Limit         *wrapperspb.Int64Value  `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRsvpsRequest) Reset() {
+	*x = ListRsvpsRequest{}
+	mi := &file_events_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRsvpsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRsvpsRequest) ProtoMessage() {}
+
+func (x *ListRsvpsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRsvpsRequest.ProtoReflect.Descriptor instead.
+func (*ListRsvpsRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListRsvpsRequest) GetStatus() []RSVP_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListRsvpsRequest) GetLimit() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *ListRsvpsRequest) GetCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+type Rsvp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        RSVP_STATUS            `protobuf:"varint,1,opt,name=status,proto3,enum=events_v1.RSVP_STATUS" json:"status,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	Event         *Event                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rsvp) Reset() {
+	*x = Rsvp{}
+	mi := &file_events_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rsvp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rsvp) ProtoMessage() {}
+
+func (x *Rsvp) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rsvp.ProtoReflect.Descriptor instead.
+func (*Rsvp) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Rsvp) GetStatus() RSVP_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return RSVP_STATUS_rsvp_unspecified
+}
+
+func (x *Rsvp) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Rsvp) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type ListRsvpsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Data          []*Rsvp                 `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRsvpsResponse) Reset() {
+	*x = ListRsvpsResponse{}
+	mi := &file_events_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRsvpsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRsvpsResponse) ProtoMessage() {}
+
+func (x *ListRsvpsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRsvpsResponse.ProtoReflect.Descriptor instead.
+func (*ListRsvpsResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListRsvpsResponse) GetData() []*Rsvp {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListRsvpsResponse) GetNextCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+type SetEventCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Categories    []string               `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventCategoriesRequest) Reset() {
+	*x = SetEventCategoriesRequest{}
+	mi := &file_events_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventCategoriesRequest) ProtoMessage() {}
+
+func (x *SetEventCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*SetEventCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetEventCategoriesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetEventCategoriesRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type SuggestEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	Limit         *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestEventsRequest) Reset() {
+	*x = SuggestEventsRequest{}
+	mi := &file_events_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestEventsRequest) ProtoMessage() {}
+
+func (x *SuggestEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestEventsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestEventsRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SuggestEventsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SuggestEventsRequest) GetLimit() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Type          SUGGESTION_TYPE         `protobuf:"varint,1,opt,name=type,proto3,enum=events_v1.SUGGESTION_TYPE" json:"type,omitempty"`
+	Text          string                  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	EventId       *wrapperspb.Int64Value  `protobuf:"bytes,3,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	Code          *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Score         float32                 `protobuf:"fixed32,5,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_events_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Suggestion) GetType() SUGGESTION_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return SUGGESTION_TYPE_event
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetEventId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.EventId
+	}
+	return nil
+}
+
+func (x *Suggestion) GetCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *Suggestion) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SuggestEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestEventsResponse) Reset() {
+	*x = SuggestEventsResponse{}
+	mi := &file_events_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestEventsResponse) ProtoMessage() {}
+
+func (x *SuggestEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestEventsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestEventsResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SuggestEventsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type EventsMapRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	MinLat        float64                 `protobuf:"fixed64,1,opt,name=min_lat,proto3" json:"min_lat,omitempty"`
+	MinLon        float64                 `protobuf:"fixed64,2,opt,name=min_lon,proto3" json:"min_lon,omitempty"`
+	MaxLat        float64                 `protobuf:"fixed64,3,opt,name=max_lat,proto3" json:"max_lat,omitempty"`
+	MaxLon        float64                 `protobuf:"fixed64,4,opt,name=max_lon,proto3" json:"max_lon,omitempty"`
+	Zoom          int32                   `protobuf:"varint,5,opt,name=zoom,proto3" json:"zoom,omitempty"`
+	Q             *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=q,proto3" json:"q,omitempty"`
+	City          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	District      *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=district,proto3" json:"district,omitempty"`
+	MinPrice      *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	MaxPrice      *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	EventDate     *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=event_date,proto3" json:"event_date,omitempty"`
+	EventType     *EVENT_TYPE             `protobuf:"varint,12,opt,name=event_type,proto3,enum=events_v1.EVENT_TYPE,oneof" json:"event_type,omitempty"`
+	Category      []string                `protobuf:"bytes,13,rep,name=category,proto3" json:"category,omitempty"`
+	DateFrom      *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=date_from,proto3" json:"date_from,omitempty"`
+	DateTo        *wrapperspb.StringValue `protobuf:"bytes,15,opt,name=date_to,proto3" json:"date_to,omitempty"`
+	Currency      *wrapperspb.StringValue `protobuf:"bytes,16,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventsMapRequest) Reset() {
+	*x = EventsMapRequest{}
+	mi := &file_events_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventsMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsMapRequest) ProtoMessage() {}
+
+func (x *EventsMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsMapRequest.ProtoReflect.Descriptor instead.
+func (*EventsMapRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EventsMapRequest) GetMinLat() float64 {
+	if x != nil {
+		return x.MinLat
+	}
+	return 0
+}
+
+func (x *EventsMapRequest) GetMinLon() float64 {
+	if x != nil {
+		return x.MinLon
+	}
+	return 0
+}
+
+func (x *EventsMapRequest) GetMaxLat() float64 {
+	if x != nil {
+		return x.MaxLat
+	}
+	return 0
+}
+
+func (x *EventsMapRequest) GetMaxLon() float64 {
+	if x != nil {
+		return x.MaxLon
+	}
+	return 0
+}
+
+func (x *EventsMapRequest) GetZoom() int32 {
+	if x != nil {
+		return x.Zoom
+	}
+	return 0
+}
+
+func (x *EventsMapRequest) GetQ() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Q
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetCity() *wrapperspb.StringValue {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetDistrict() *wrapperspb.StringValue {
+	if x != nil {
+		return x.District
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetMinPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetMaxPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetEventDate() *wrapperspb.StringValue {
+	if x != nil {
+		return x.EventDate
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetEventType() EVENT_TYPE {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return EVENT_TYPE_offline
+}
+
+func (x *EventsMapRequest) GetCategory() []string {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetDateFrom() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetDateTo() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *EventsMapRequest) GetCurrency() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+type MapCluster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	SampleIds     []int64                `protobuf:"varint,4,rep,packed,name=sample_ids,proto3" json:"sample_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapCluster) Reset() {
+	*x = MapCluster{}
+	mi := &file_events_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapCluster) ProtoMessage() {}
+
+func (x *MapCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapCluster.ProtoReflect.Descriptor instead.
+func (*MapCluster) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MapCluster) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MapCluster) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *MapCluster) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *MapCluster) GetSampleIds() []int64 {
+	if x != nil {
+		return x.SampleIds
+	}
+	return nil
+}
+
+type EventsMapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*MapCluster          `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventsMapResponse) Reset() {
+	*x = EventsMapResponse{}
+	mi := &file_events_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventsMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsMapResponse) ProtoMessage() {}
+
+func (x *EventsMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsMapResponse.ProtoReflect.Descriptor instead.
+func (*EventsMapResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EventsMapResponse) GetClusters() []*MapCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type CategoryAlias struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Category      *EventCategory         `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAlias) Reset() {
+	*x = CategoryAlias{}
+	mi := &file_events_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAlias) ProtoMessage() {}
+
+func (x *CategoryAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAlias.ProtoReflect.Descriptor instead.
+func (*CategoryAlias) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CategoryAlias) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *CategoryAlias) GetCategory() *EventCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type ListCategoryAliasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aliases       []*CategoryAlias       `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryAliasesResponse) Reset() {
+	*x = ListCategoryAliasesResponse{}
+	mi := &file_events_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryAliasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryAliasesResponse) ProtoMessage() {}
+
+func (x *ListCategoryAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryAliasesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListCategoryAliasesResponse) GetAliases() []*CategoryAlias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type SetCategoryAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	CategoryCode  string                 `protobuf:"bytes,2,opt,name=category_code,proto3" json:"category_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryAliasRequest) Reset() {
+	*x = SetCategoryAliasRequest{}
+	mi := &file_events_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAliasRequest) ProtoMessage() {}
+
+func (x *SetCategoryAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAliasRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryAliasRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetCategoryAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *SetCategoryAliasRequest) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+type DeleteCategoryAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryAliasRequest) Reset() {
+	*x = DeleteCategoryAliasRequest{}
+	mi := &file_events_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryAliasRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryAliasRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryAliasRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteCategoryAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type ReplayDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 - значение по умолчанию (100)
+	Limit         int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	mi := &file_events_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReplayDeadLettersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replayed      int64                  `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	Skipped       int64                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	mi := &file_events_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayed() int64 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+func (x *ReplayDeadLettersResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type CurrencyRate struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Currency string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	// сколько единиц валюты дают за 1 USD
+	Rate          float64                `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyRate) Reset() {
+	*x = CurrencyRate{}
+	mi := &file_events_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyRate) ProtoMessage() {}
+
+func (x *CurrencyRate) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyRate.ProtoReflect.Descriptor instead.
+func (*CurrencyRate) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CurrencyRate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CurrencyRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListCurrencyRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*CurrencyRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCurrencyRatesResponse) Reset() {
+	*x = ListCurrencyRatesResponse{}
+	mi := &file_events_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrencyRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrencyRatesResponse) ProtoMessage() {}
+
+func (x *ListCurrencyRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrencyRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCurrencyRatesResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListCurrencyRatesResponse) GetRates() []*CurrencyRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ListTrendingEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Limit         *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingEventsRequest) Reset() {
+	*x = ListTrendingEventsRequest{}
+	mi := &file_events_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingEventsRequest) ProtoMessage() {}
+
+func (x *ListTrendingEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingEventsRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTrendingEventsRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ListTrendingEventsRequest) GetLimit() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+// TrendingEvent - сигналы популярности события за последние 7 дней
+type TrendingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Views         int32                  `protobuf:"varint,3,opt,name=views,proto3" json:"views,omitempty"`
+	Favorites     int32                  `protobuf:"varint,4,opt,name=favorites,proto3" json:"favorites,omitempty"`
+	Rsvps         int32                  `protobuf:"varint,5,opt,name=rsvps,proto3" json:"rsvps,omitempty"`
+	Reviews       int32                  `protobuf:"varint,6,opt,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingEvent) Reset() {
+	*x = TrendingEvent{}
+	mi := &file_events_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingEvent) ProtoMessage() {}
+
+func (x *TrendingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingEvent.ProtoReflect.Descriptor instead.
+func (*TrendingEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TrendingEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TrendingEvent) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TrendingEvent) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TrendingEvent) GetFavorites() int32 {
+	if x != nil {
+		return x.Favorites
+	}
+	return 0
+}
+
+func (x *TrendingEvent) GetRsvps() int32 {
+	if x != nil {
+		return x.Rsvps
+	}
+	return 0
+}
+
+func (x *TrendingEvent) GetReviews() int32 {
+	if x != nil {
+		return x.Reviews
+	}
+	return 0
+}
+
+type ListTrendingEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*TrendingEvent       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingEventsResponse) Reset() {
+	*x = ListTrendingEventsResponse{}
+	mi := &file_events_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingEventsResponse) ProtoMessage() {}
+
+func (x *ListTrendingEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingEventsResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTrendingEventsResponse) GetData() []*TrendingEvent {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExportEventIcsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEventIcsRequest) Reset() {
+	*x = ExportEventIcsRequest{}
+	mi := &file_events_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEventIcsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEventIcsRequest) ProtoMessage() {}
+
+func (x *ExportEventIcsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEventIcsRequest.ProtoReflect.Descriptor instead.
+func (*ExportEventIcsRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportEventIcsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CalendarFeed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// http_url - для Google Calendar ("добавить по URL"), webcal_url - для Apple Calendar
+	HttpUrl       string                 `protobuf:"bytes,1,opt,name=http_url,proto3" json:"http_url,omitempty"`
+	WebcalUrl     string                 `protobuf:"bytes,2,opt,name=webcal_url,proto3" json:"webcal_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_events_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CalendarFeed) GetHttpUrl() string {
+	if x != nil {
+		return x.HttpUrl
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetWebcalUrl() string {
+	if x != nil {
+		return x.WebcalUrl
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetCalendarFeedIcsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedIcsRequest) Reset() {
+	*x = GetCalendarFeedIcsRequest{}
+	mi := &file_events_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedIcsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedIcsRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedIcsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[43]
 	if x != nil {
-		return x.MinPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FiltersValues) GetMaxPrice() *wrapperspb.Int32Value {
+// Deprecated: Use GetCalendarFeedIcsRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedIcsRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCalendarFeedIcsRequest) GetToken() string {
 	if x != nil {
-		return x.MaxPrice
+		return x.Token
+	}
+	return ""
+}
+
+type ReminderSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// за сколько часов до начала сеанса напоминать
+	HoursBefore   int32 `protobuf:"varint,1,opt,name=hours_before,proto3" json:"hours_before,omitempty"`
+	Enabled       bool  `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReminderSettings) Reset() {
+	*x = ReminderSettings{}
+	mi := &file_events_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReminderSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReminderSettings) ProtoMessage() {}
+
+func (x *ReminderSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReminderSettings.ProtoReflect.Descriptor instead.
+func (*ReminderSettings) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReminderSettings) GetHoursBefore() int32 {
+	if x != nil {
+		return x.HoursBefore
+	}
+	return 0
+}
+
+func (x *ReminderSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// GetEventAnalyticsRequest - статистика ровно по одному из event_id, city или category
+type GetEventAnalyticsRequest struct {
+	state    protoimpl.MessageState  `protogen:"open.v1"`
+	EventId  *wrapperspb.Int64Value  `protobuf:"bytes,1,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	City     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Category *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// диапазон дат (UTC) в формате YYYY-MM-DD, date_to включительно
+	DateFrom      string `protobuf:"bytes,4,opt,name=date_from,proto3" json:"date_from,omitempty"`
+	DateTo        string `protobuf:"bytes,5,opt,name=date_to,proto3" json:"date_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventAnalyticsRequest) Reset() {
+	*x = GetEventAnalyticsRequest{}
+	mi := &file_events_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetEventAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetEventAnalyticsRequest) GetEventId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.EventId
 	}
 	return nil
 }
 
-func (x *FiltersValues) GetCities() []string {
+func (x *GetEventAnalyticsRequest) GetCity() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Cities
+		return x.City
 	}
 	return nil
 }
 
-func (x *FiltersValues) GetCategories() []*EventCategory {
+func (x *GetEventAnalyticsRequest) GetCategory() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Categories
+		return x.Category
 	}
 	return nil
 }
 
-type ListEventsResponse struct {
+func (x *GetEventAnalyticsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetEventAnalyticsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+type ViewStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD, в итоге - начало диапазона
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Views         int64  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	UniqueViewers int64  `protobuf:"varint,3,opt,name=unique_viewers,proto3" json:"unique_viewers,omitempty"`
+	Favorites     int64  `protobuf:"varint,4,opt,name=favorites,proto3" json:"favorites,omitempty"`
+	Impressions   int64  `protobuf:"varint,5,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	ListViews     int64  `protobuf:"varint,6,opt,name=list_views,proto3" json:"list_views,omitempty"`
+	// list_views / impressions
+	Ctr           float64 `protobuf:"fixed64,7,opt,name=ctr,proto3" json:"ctr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewStats) Reset() {
+	*x = ViewStats{}
+	mi := &file_events_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewStats) ProtoMessage() {}
+
+func (x *ViewStats) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewStats.ProtoReflect.Descriptor instead.
+func (*ViewStats) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ViewStats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ViewStats) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *ViewStats) GetUniqueViewers() int64 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+func (x *ViewStats) GetFavorites() int64 {
+	if x != nil {
+		return x.Favorites
+	}
+	return 0
+}
+
+func (x *ViewStats) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *ViewStats) GetListViews() int64 {
+	if x != nil {
+		return x.ListViews
+	}
+	return 0
+}
+
+func (x *ViewStats) GetCtr() float64 {
+	if x != nil {
+		return x.Ctr
+	}
+	return 0
+}
+
+type GetEventAnalyticsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Event               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Filters       *FiltersValues         `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	Total         *ViewStats             `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Days          []*ViewStats           `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
-	mi := &file_events_proto_msgTypes[7]
+func (x *GetEventAnalyticsResponse) Reset() {
+	*x = GetEventAnalyticsResponse{}
+	mi := &file_events_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *GetEventAnalyticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*GetEventAnalyticsResponse) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[7]
+func (x *GetEventAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,33 +3491,123 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetEventAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListEventsResponse) GetData() []*Event {
+func (x *GetEventAnalyticsResponse) GetTotal() *ViewStats {
 	if x != nil {
-		return x.Data
+		return x.Total
 	}
 	return nil
 }
 
-func (x *ListEventsResponse) GetFilters() *FiltersValues {
+func (x *GetEventAnalyticsResponse) GetDays() []*ViewStats {
 	if x != nil {
-		return x.Filters
+		return x.Days
 	}
 	return nil
 }
 
+type ImportCurrencyRatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CSV со строками "currency,rate", строка заголовка и строки с # пропускаются
+	File          string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCurrencyRatesRequest) Reset() {
+	*x = ImportCurrencyRatesRequest{}
+	mi := &file_events_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCurrencyRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCurrencyRatesRequest) ProtoMessage() {}
+
+func (x *ImportCurrencyRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCurrencyRatesRequest.ProtoReflect.Descriptor instead.
+func (*ImportCurrencyRatesRequest) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImportCurrencyRatesRequest) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type ImportCurrencyRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int64                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCurrencyRatesResponse) Reset() {
+	*x = ImportCurrencyRatesResponse{}
+	mi := &file_events_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCurrencyRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCurrencyRatesResponse) ProtoMessage() {}
+
+func (x *ImportCurrencyRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCurrencyRatesResponse.ProtoReflect.Descriptor instead.
+func (*ImportCurrencyRatesResponse) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportCurrencyRatesResponse) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
 var File_events_proto protoreflect.FileDescriptor
 
 const file_events_proto_rawDesc = "" +
 	"\n" +
-	"\fevents.proto\x12\tevents_v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x1c\n" +
+	"\fevents.proto\x12\tevents_v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\"V\n" +
 	"\n" +
 	"GetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"o\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x128\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x16.events_v1.VIEW_SOURCEB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06source\"o\n" +
 	"\vGetResponse\x12&\n" +
 	"\x05event\x18\x01 \x01(\v2\x10.events_v1.EventR\x05event\x128\n" +
 	"\n" +
@@ -765,7 +3623,7 @@ const file_events_proto_rawDesc = "" +
 	"\bdistrict\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\bdistrict\x12>\n" +
 	"\vpostal_code\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\vpostal_code\x127\n" +
 	"\blatitude\x18\b \x01(\v2\x1b.google.protobuf.FloatValueR\blatitude\x129\n" +
-	"\tlongitude\x18\t \x01(\v2\x1b.google.protobuf.FloatValueR\tlongitude\"\x97\a\n" +
+	"\tlongitude\x18\t \x01(\v2\x1b.google.protobuf.FloatValueR\tlongitude\"\x93\v\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12>\n" +
@@ -789,9 +3647,36 @@ const file_events_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"updated_at\x128\n" +
-	"\bcurrency\x18\x11 \x01(\v2\x1c.google.protobuf.StringValueR\bcurrencyB\n" +
+	"\bcurrency\x18\x11 \x01(\v2\x1c.google.protobuf.StringValueR\bcurrency\x12:\n" +
+	"\thighlight\x18\x12 \x01(\v2\x1c.google.protobuf.StringValueR\thighlight\x12<\n" +
+	"\n" +
+	"distance_m\x18\x13 \x01(\v2\x1c.google.protobuf.DoubleValueR\n" +
+	"distance_m\x123\n" +
+	"\bsessions\x18\x14 \x03(\v2\x17.events_v1.EventSessionR\bsessions\x129\n" +
+	"\tmax_price\x18\x15 \x01(\v2\x1b.google.protobuf.Int32ValueR\tmax_price\x12\x18\n" +
+	"\ais_free\x18\x16 \x01(\bR\ais_free\x12(\n" +
+	"\x0ffavorites_count\x18\x17 \x01(\x05R\x0ffavorites_count\x12 \n" +
+	"\vis_favorite\x18\x18 \x01(\bR\vis_favorite\x12 \n" +
+	"\vgoing_count\x18\x19 \x01(\x05R\vgoing_count\x12P\n" +
+	"\x14recommendation_score\x18\x1a \x01(\v2\x1c.google.protobuf.DoubleValueR\x14recommendation_score\x126\n" +
+	"\x16recommendation_reasons\x18\x1b \x03(\tR\x16recommendation_reasonsB\n" +
 	"\n" +
-	"\b_address\"\x97\x05\n" +
+	"\b_address\"\x85\x03\n" +
+	"\fEventSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x128\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstarts_at\x124\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aends_at\x129\n" +
+	"\tmin_price\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\tmin_price\x128\n" +
+	"\bcurrency\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\bcurrency\x12E\n" +
+	"\x0fseats_available\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fseats_available\x129\n" +
+	"\tmax_price\x18\a \x01(\v2\x1b.google.protobuf.Int32ValueR\tmax_price\"\x83\x03\n" +
+	"\x10EventSessionInfo\x12B\n" +
+	"\tstarts_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\x8a\x01\x02\x10\x01R\tstarts_at\x124\n" +
+	"\aends_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aends_at\x129\n" +
+	"\tmin_price\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\tmin_price\x128\n" +
+	"\bcurrency\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\bcurrency\x12E\n" +
+	"\x0fseats_available\x18\x05 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fseats_available\x129\n" +
+	"\tmax_price\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\tmax_price\"\xc8\a\n" +
 	"\x11ListEventsRequest\x12*\n" +
 	"\x01q\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x01q\x120\n" +
 	"\x04sort\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04sort\x120\n" +
@@ -807,32 +3692,273 @@ const file_events_proto_rawDesc = "" +
 	"event_type\x88\x01\x01\x12\x1a\n" +
 	"\bcategory\x18\t \x03(\tR\bcategory\x121\n" +
 	"\x05limit\x18\n" +
-	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x05limit\x125\n" +
-	"\alast_id\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\alast_id\x123\n" +
-	"\x06offset\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x06offsetB\r\n" +
-	"\v_event_type\"9\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x05limit\x12.\n" +
+	"\x03lat\x18\r \x01(\v2\x1c.google.protobuf.DoubleValueR\x03lat\x12.\n" +
+	"\x03lon\x18\x0e \x01(\v2\x1c.google.protobuf.DoubleValueR\x03lon\x12:\n" +
+	"\tradius_km\x18\x0f \x01(\v2\x1c.google.protobuf.DoubleValueR\tradius_km\x124\n" +
+	"\x06cursor\x18\x10 \x01(\v2\x1c.google.protobuf.StringValueR\x06cursor\x12:\n" +
+	"\tdate_from\x18\x11 \x01(\v2\x1c.google.protobuf.StringValueR\tdate_from\x126\n" +
+	"\adate_to\x18\x12 \x01(\v2\x1c.google.protobuf.StringValueR\adate_to\x128\n" +
+	"\bcurrency\x18\x13 \x01(\v2\x1c.google.protobuf.StringValueR\bcurrencyB\r\n" +
+	"\v_event_typeJ\x04\b\v\x10\fJ\x04\b\f\x10\rR\alast_idR\x06offset\"9\n" +
 	"\rEventCategory\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\"\xd7\x01\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xf3\x01\n" +
 	"\rFiltersValues\x129\n" +
 	"\tmin_price\x18\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\tmin_price\x129\n" +
 	"\tmax_price\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\tmax_price\x12\x16\n" +
 	"\x06cities\x18\x03 \x03(\tR\x06cities\x128\n" +
 	"\n" +
 	"categories\x18\x04 \x03(\v2\x18.events_v1.EventCategoryR\n" +
-	"categories\"n\n" +
+	"categories\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\xae\x01\n" +
 	"\x12ListEventsResponse\x12$\n" +
 	"\x04data\x18\x01 \x03(\v2\x10.events_v1.EventR\x04data\x122\n" +
-	"\afilters\x18\x02 \x01(\v2\x18.events_v1.FiltersValuesR\afilters*%\n" +
+	"\afilters\x18\x02 \x01(\v2\x18.events_v1.FiltersValuesR\afilters\x12>\n" +
+	"\vnext_cursor\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vnext_cursor\"\x97\x06\n" +
+	"\tEventInfo\x12 \n" +
+	"\x05title\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05title\x12>\n" +
+	"\vdescription\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x12\x1e\n" +
+	"\x04link\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04link\x125\n" +
+	"\amin_age\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\amin_age\x12E\n" +
+	"\x0fseats_available\x18\x05 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fseats_available\x12?\n" +
+	"\n" +
+	"event_type\x18\x06 \x01(\x0e2\x15.events_v1.EVENT_TYPEB\b\xfaB\x05\x82\x01\x02\x10\x01R\n" +
+	"event_type\x129\n" +
+	"\tmin_price\x18\a \x01(\v2\x1b.google.protobuf.Int32ValueR\tmin_price\x128\n" +
+	"\bcurrency\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\bcurrency\x12B\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\x8a\x01\x02\x10\x01R\tstarts_at\x12:\n" +
+	"\timage_url\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\timage_url\x12;\n" +
+	"\aaddress\x18\v \x01(\v2\x17.events_v1.EventAddressB\b\xfaB\x05\x8a\x01\x02\x10\x01R\aaddress\x12B\n" +
+	"\bsessions\x18\f \x03(\v2\x1b.events_v1.EventSessionInfoB\t\xfaB\x06\x92\x01\x03\x10\xf4\x03R\bsessions\x129\n" +
+	"\tmax_price\x18\r \x01(\v2\x1b.google.protobuf.Int32ValueR\tmax_price\x12\x18\n" +
+	"\ais_free\x18\x0e \x01(\bR\ais_free\"j\n" +
+	"\x12CreateEventRequest\x124\n" +
+	"\x05event\x18\x01 \x01(\v2\x14.events_v1.EventInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05event\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\tR\n" +
+	"categories\"%\n" +
+	"\x13CreateEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"c\n" +
+	"\x12UpdateEventRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x124\n" +
+	"\x05event\x18\x02 \x01(\v2\x14.events_v1.EventInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05event\"-\n" +
+	"\x12DeleteEventRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"6\n" +
+	"\x0fFavoriteRequest\x12#\n" +
+	"\bevent_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bevent_id\"\xa3\x01\n" +
+	"\x14ListFavoritesRequest\x12\"\n" +
+	"\finclude_past\x18\x01 \x01(\bR\finclude_past\x121\n" +
+	"\x05limit\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05limit\x124\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x06cursor\"}\n" +
+	"\x15ListFavoritesResponse\x12$\n" +
+	"\x04data\x18\x01 \x03(\v2\x10.events_v1.EventR\x04data\x12>\n" +
+	"\vnext_cursor\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vnext_cursor\"o\n" +
+	"\x0eSetRsvpRequest\x12#\n" +
+	"\bevent_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bevent_id\x128\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.events_v1.RSVP_STATUSB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\"A\n" +
+	"\x0fSetRsvpResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.events_v1.RSVP_STATUSR\x06status\"\xab\x01\n" +
+	"\x10ListRsvpsRequest\x12.\n" +
+	"\x06status\x18\x01 \x03(\x0e2\x16.events_v1.RSVP_STATUSR\x06status\x121\n" +
+	"\x05limit\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05limit\x124\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x06cursor\"\x9a\x01\n" +
+	"\x04Rsvp\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.events_v1.RSVP_STATUSR\x06status\x12:\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12&\n" +
+	"\x05event\x18\x03 \x01(\v2\x10.events_v1.EventR\x05event\"x\n" +
+	"\x11ListRsvpsResponse\x12#\n" +
+	"\x04data\x18\x01 \x03(\v2\x0f.events_v1.RsvpR\x04data\x12>\n" +
+	"\vnext_cursor\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vnext_cursor\"T\n" +
+	"\x19SetEventCategoriesRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\tR\n" +
+	"categories\"b\n" +
+	"\x14SuggestEventsRequest\x12\x17\n" +
+	"\x01q\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x01q\x121\n" +
+	"\x05limit\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05limit\"\xd1\x01\n" +
+	"\n" +
+	"Suggestion\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.events_v1.SUGGESTION_TYPER\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x127\n" +
+	"\bevent_id\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\bevent_id\x120\n" +
+	"\x04code\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x04code\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x02R\x05score\"P\n" +
+	"\x15SuggestEventsResponse\x127\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x15.events_v1.SuggestionR\vsuggestions\"\xde\x06\n" +
+	"\x10EventsMapRequest\x121\n" +
+	"\amin_lat\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\amin_lat\x121\n" +
+	"\amin_lon\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\amin_lon\x121\n" +
+	"\amax_lat\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\amax_lat\x121\n" +
+	"\amax_lon\x18\x04 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\amax_lon\x12\x1d\n" +
+	"\x04zoom\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x16(\x00R\x04zoom\x12*\n" +
+	"\x01q\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x01q\x120\n" +
+	"\x04city\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x04city\x128\n" +
+	"\bdistrict\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\bdistrict\x129\n" +
+	"\tmin_price\x18\t \x01(\v2\x1b.google.protobuf.Int32ValueR\tmin_price\x129\n" +
+	"\tmax_price\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int32ValueR\tmax_price\x12<\n" +
+	"\n" +
+	"event_date\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\n" +
+	"event_date\x12:\n" +
+	"\n" +
+	"event_type\x18\f \x01(\x0e2\x15.events_v1.EVENT_TYPEH\x00R\n" +
+	"event_type\x88\x01\x01\x12\x1a\n" +
+	"\bcategory\x18\r \x03(\tR\bcategory\x12:\n" +
+	"\tdate_from\x18\x0e \x01(\v2\x1c.google.protobuf.StringValueR\tdate_from\x126\n" +
+	"\adate_to\x18\x0f \x01(\v2\x1c.google.protobuf.StringValueR\adate_to\x128\n" +
+	"\bcurrency\x18\x10 \x01(\v2\x1c.google.protobuf.StringValueR\bcurrencyB\r\n" +
+	"\v_event_type\"|\n" +
+	"\n" +
+	"MapCluster\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x1e\n" +
+	"\n" +
+	"sample_ids\x18\x04 \x03(\x03R\n" +
+	"sample_ids\"F\n" +
+	"\x11EventsMapResponse\x121\n" +
+	"\bclusters\x18\x01 \x03(\v2\x15.events_v1.MapClusterR\bclusters\"[\n" +
+	"\rCategoryAlias\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x124\n" +
+	"\bcategory\x18\x02 \x01(\v2\x18.events_v1.EventCategoryR\bcategory\"Q\n" +
+	"\x1bListCategoryAliasesResponse\x122\n" +
+	"\aaliases\x18\x01 \x03(\v2\x18.events_v1.CategoryAliasR\aaliases\"m\n" +
+	"\x17SetCategoryAliasRequest\x12 \n" +
+	"\x05alias\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05alias\x120\n" +
+	"\rcategory_code\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\rcategory_code\">\n" +
+	"\x1aDeleteCategoryAliasRequest\x12 \n" +
+	"\x05alias\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05alias\"<\n" +
+	"\x18ReplayDeadLettersRequest\x12 \n" +
+	"\x05limit\x18\x01 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\xe8\a(\x00R\x05limit\"Q\n" +
+	"\x19ReplayDeadLettersResponse\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\x03R\breplayed\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x03R\askipped\"z\n" +
+	"\fCurrencyRate\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x01R\x04rate\x12:\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"J\n" +
+	"\x19ListCurrencyRatesResponse\x12-\n" +
+	"\x05rates\x18\x01 \x03(\v2\x17.events_v1.CurrencyRateR\x05rates\"k\n" +
+	"\x19ListTrendingEventsRequest\x12\x1b\n" +
+	"\x04city\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04city\x121\n" +
+	"\x05limit\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05limit\"\xb1\x01\n" +
+	"\rTrendingEvent\x12&\n" +
+	"\x05event\x18\x01 \x01(\v2\x10.events_v1.EventR\x05event\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x14\n" +
+	"\x05views\x18\x03 \x01(\x05R\x05views\x12\x1c\n" +
+	"\tfavorites\x18\x04 \x01(\x05R\tfavorites\x12\x14\n" +
+	"\x05rsvps\x18\x05 \x01(\x05R\x05rsvps\x12\x18\n" +
+	"\areviews\x18\x06 \x01(\x05R\areviews\"J\n" +
+	"\x1aListTrendingEventsResponse\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x18.events_v1.TrendingEventR\x04data\"0\n" +
+	"\x15ExportEventIcsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\x86\x01\n" +
+	"\fCalendarFeed\x12\x1a\n" +
+	"\bhttp_url\x18\x01 \x01(\tR\bhttp_url\x12\x1e\n" +
+	"\n" +
+	"webcal_url\x18\x02 \x01(\tR\n" +
+	"webcal_url\x12:\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"<\n" +
+	"\x19GetCalendarFeedIcsRequest\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x05token\"\\\n" +
+	"\x10ReminderSettings\x12.\n" +
+	"\fhours_before\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xa8\x01(\x01R\fhours_before\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"\x8b\x02\n" +
+	"\x18GetEventAnalyticsRequest\x127\n" +
+	"\bevent_id\x18\x01 \x01(\v2\x1b.google.protobuf.Int64ValueR\bevent_id\x120\n" +
+	"\x04city\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04city\x128\n" +
+	"\bcategory\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\bcategory\x12&\n" +
+	"\tdate_from\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x98\x01\n" +
+	"R\tdate_from\x12\"\n" +
+	"\adate_to\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x98\x01\n" +
+	"R\adate_to\"\xcf\x01\n" +
+	"\tViewStats\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\x12&\n" +
+	"\x0eunique_viewers\x18\x03 \x01(\x03R\x0eunique_viewers\x12\x1c\n" +
+	"\tfavorites\x18\x04 \x01(\x03R\tfavorites\x12 \n" +
+	"\vimpressions\x18\x05 \x01(\x03R\vimpressions\x12\x1e\n" +
+	"\n" +
+	"list_views\x18\x06 \x01(\x03R\n" +
+	"list_views\x12\x10\n" +
+	"\x03ctr\x18\a \x01(\x01R\x03ctr\"q\n" +
+	"\x19GetEventAnalyticsResponse\x12*\n" +
+	"\x05total\x18\x01 \x01(\v2\x14.events_v1.ViewStatsR\x05total\x12(\n" +
+	"\x04days\x18\x02 \x03(\v2\x14.events_v1.ViewStatsR\x04days\"=\n" +
+	"\x1aImportCurrencyRatesRequest\x12\x1f\n" +
+	"\x04file\x18\x01 \x01(\tB\v\xfaB\br\x06\x10\x01\x18\x80\x80@R\x04file\"9\n" +
+	"\x1bImportCurrencyRatesResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x03R\bimported*[\n" +
+	"\vVIEW_SOURCE\x12\x1b\n" +
+	"\x17view_source_unspecified\x10\x00\x12\b\n" +
+	"\x04list\x10\x01\x12\r\n" +
+	"\tdeep_link\x10\x02\x12\a\n" +
+	"\x03map\x10\x03\x12\r\n" +
+	"\tfavorites\x10\x04*%\n" +
 	"\n" +
 	"EVENT_TYPE\x12\v\n" +
 	"\aoffline\x10\x00\x12\n" +
 	"\n" +
-	"\x06online\x10\x012\xc2\x01\n" +
+	"\x06online\x10\x01*]\n" +
+	"\vRSVP_STATUS\x12\x14\n" +
+	"\x10rsvp_unspecified\x10\x00\x12\t\n" +
+	"\x05going\x10\x01\x12\x0e\n" +
+	"\n" +
+	"interested\x10\x02\x12\r\n" +
+	"\tnot_going\x10\x03\x12\x0e\n" +
+	"\n" +
+	"waitlisted\x10\x04*?\n" +
+	"\x0fSUGGESTION_TYPE\x12\t\n" +
+	"\x05event\x10\x00\x12\t\n" +
+	"\x05venue\x10\x01\x12\b\n" +
+	"\x04city\x10\x02\x12\f\n" +
+	"\bcategory\x10\x032\x88\x18\n" +
 	"\bEvent_V1\x12R\n" +
 	"\bGetEvent\x12\x15.events_v1.GetRequest\x1a\x16.events_v1.GetResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/events/v1/{id}\x12b\n" +
 	"\n" +
-	"ListEvents\x12\x1c.events_v1.ListEventsRequest\x1a\x1d.events_v1.ListEventsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/events/v1/listB?Z=GolandProjects/RelocatorEvents/events/pkg/events_v1;events_v1b\x06proto3"
+	"ListEvents\x12\x1c.events_v1.ListEventsRequest\x1a\x1d.events_v1.ListEventsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/events/v1/list\x12a\n" +
+	"\fGetEventsMap\x12\x1b.events_v1.EventsMapRequest\x1a\x1c.events_v1.EventsMapResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/events/v1/map\x12n\n" +
+	"\rSuggestEvents\x12\x1f.events_v1.SuggestEventsRequest\x1a .events_v1.SuggestEventsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/events/v1/suggest\x12i\n" +
+	"\vAddFavorite\x12\x1a.events_v1.FavoriteRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 \x1a\x1e/events/v1/{event_id}/favorite\x12l\n" +
+	"\x0eRemoveFavorite\x12\x1a.events_v1.FavoriteRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/events/v1/{event_id}/favorite\x12p\n" +
+	"\rListFavorites\x12\x1f.events_v1.ListFavoritesRequest\x1a .events_v1.ListFavoritesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/events/v1/favorites\x12g\n" +
+	"\aSetRsvp\x12\x19.events_v1.SetRsvpRequest\x1a\x1a.events_v1.SetRsvpResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/events/v1/{event_id}/rsvp\x12`\n" +
+	"\tListRsvps\x12\x1b.events_v1.ListRsvpsRequest\x1a\x1c.events_v1.ListRsvpsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/events/v1/rsvps\x12c\n" +
+	"\vCreateEvent\x12\x1d.events_v1.CreateEventRequest\x1a\x1e.events_v1.CreateEventResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/events/v1\x12`\n" +
+	"\vUpdateEvent\x12\x1d.events_v1.UpdateEventRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/events/v1/{id}\x12]\n" +
+	"\vDeleteEvent\x12\x1d.events_v1.DeleteEventRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/events/v1/{id}\x12y\n" +
+	"\x12SetEventCategories\x12$.events_v1.SetEventCategoriesRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/events/v1/{id}/categories\x12|\n" +
+	"\x13ListCategoryAliases\x12\x16.google.protobuf.Empty\x1a&.events_v1.ListCategoryAliasesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/events/v1/categories/aliases\x12\x80\x01\n" +
+	"\x10SetCategoryAlias\x12\".events_v1.SetCategoryAliasRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/events/v1/categories/aliases/{alias}\x12\x83\x01\n" +
+	"\x13DeleteCategoryAlias\x12%.events_v1.DeleteCategoryAliasRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02'*%/events/v1/categories/aliases/{alias}\x12\x80\x01\n" +
+	"\x11ReplayDeadLetters\x12#.events_v1.ReplayDeadLettersRequest\x1a$.events_v1.ReplayDeadLettersResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/events/v1/dlq/replay\x12t\n" +
+	"\x11ListCurrencyRates\x12\x16.google.protobuf.Empty\x1a$.events_v1.ListCurrencyRatesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/events/v1/currency-rates\x12\x91\x01\n" +
+	"\x13ImportCurrencyRates\x12%.events_v1.ImportCurrencyRatesRequest\x1a&.events_v1.ImportCurrencyRatesResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /events/v1/currency-rates/import\x12~\n" +
+	"\x12ListTrendingEvents\x12$.events_v1.ListTrendingEventsRequest\x1a%.events_v1.ListTrendingEventsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/events/v1/trending\x12|\n" +
+	"\x11GetEventAnalytics\x12#.events_v1.GetEventAnalyticsRequest\x1a$.events_v1.GetEventAnalyticsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/events/v1/analytics\x12e\n" +
+	"\x0eExportEventIcs\x12 .events_v1.ExportEventIcsRequest\x1a\x14.google.api.HttpBody\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/events/v1/{id}/ics\x12d\n" +
+	"\x0fGetCalendarFeed\x12\x16.google.protobuf.Empty\x1a\x17.events_v1.CalendarFeed\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/events/v1/calendar/feed\x12o\n" +
+	"\x11ResetCalendarFeed\x12\x16.google.protobuf.Empty\x1a\x17.events_v1.CalendarFeed\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/events/v1/calendar/feed/reset\x12y\n" +
+	"\x12GetCalendarFeedIcs\x12$.events_v1.GetCalendarFeedIcsRequest\x1a\x14.google.api.HttpBody\"'\x82\xd3\xe4\x93\x02!\x12\x1f/events/v1/calendar/{token}/ics\x12q\n" +
+	"\x13GetReminderSettings\x12\x16.google.protobuf.Empty\x1a\x1b.events_v1.ReminderSettings\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/events/v1/reminders/settings\x12|\n" +
+	"\x16UpdateReminderSettings\x12\x1b.events_v1.ReminderSettings\x1a\x1b.events_v1.ReminderSettings\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/events/v1/reminders/settingsB?Z=GolandProjects/RelocatorEvents/events/pkg/events_v1;events_v1b\x06proto3"
 
 var (
 	file_events_proto_rawDescOnce sync.Once
@@ -846,71 +3972,249 @@ func file_events_proto_rawDescGZIP() []byte {
 	return file_events_proto_rawDescData
 }
 
-var file_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_events_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_events_proto_goTypes = []any{
-	(EVENT_TYPE)(0),                // 0: events_v1.EVENT_TYPE
-	(*GetRequest)(nil),             // 1: events_v1.GetRequest
-	(*GetResponse)(nil),            // 2: events_v1.GetResponse
-	(*EventAddress)(nil),           // 3: events_v1.EventAddress
-	(*Event)(nil),                  // 4: events_v1.Event
-	(*ListEventsRequest)(nil),      // 5: events_v1.ListEventsRequest
-	(*EventCategory)(nil),          // 6: events_v1.EventCategory
-	(*FiltersValues)(nil),          // 7: events_v1.FiltersValues
-	(*ListEventsResponse)(nil),     // 8: events_v1.ListEventsResponse
-	(*wrapperspb.StringValue)(nil), // 9: google.protobuf.StringValue
-	(*wrapperspb.FloatValue)(nil),  // 10: google.protobuf.FloatValue
-	(*wrapperspb.Int32Value)(nil),  // 11: google.protobuf.Int32Value
-	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
-	(*wrapperspb.Int64Value)(nil),  // 13: google.protobuf.Int64Value
+	(VIEW_SOURCE)(0),                    // 0: events_v1.VIEW_SOURCE
+	(EVENT_TYPE)(0),                     // 1: events_v1.EVENT_TYPE
+	(RSVP_STATUS)(0),                    // 2: events_v1.RSVP_STATUS
+	(SUGGESTION_TYPE)(0),                // 3: events_v1.SUGGESTION_TYPE
+	(*GetRequest)(nil),                  // 4: events_v1.GetRequest
+	(*GetResponse)(nil),                 // 5: events_v1.GetResponse
+	(*EventAddress)(nil),                // 6: events_v1.EventAddress
+	(*Event)(nil),                       // 7: events_v1.Event
+	(*EventSession)(nil),                // 8: events_v1.EventSession
+	(*EventSessionInfo)(nil),            // 9: events_v1.EventSessionInfo
+	(*ListEventsRequest)(nil),           // 10: events_v1.ListEventsRequest
+	(*EventCategory)(nil),               // 11: events_v1.EventCategory
+	(*FiltersValues)(nil),               // 12: events_v1.FiltersValues
+	(*ListEventsResponse)(nil),          // 13: events_v1.ListEventsResponse
+	(*EventInfo)(nil),                   // 14: events_v1.EventInfo
+	(*CreateEventRequest)(nil),          // 15: events_v1.CreateEventRequest
+	(*CreateEventResponse)(nil),         // 16: events_v1.CreateEventResponse
+	(*UpdateEventRequest)(nil),          // 17: events_v1.UpdateEventRequest
+	(*DeleteEventRequest)(nil),          // 18: events_v1.DeleteEventRequest
+	(*FavoriteRequest)(nil),             // 19: events_v1.FavoriteRequest
+	(*ListFavoritesRequest)(nil),        // 20: events_v1.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),       // 21: events_v1.ListFavoritesResponse
+	(*SetRsvpRequest)(nil),              // 22: events_v1.SetRsvpRequest
+	(*SetRsvpResponse)(nil),             // 23: events_v1.SetRsvpResponse
+	(*ListRsvpsRequest)(nil),            // 24: events_v1.ListRsvpsRequest
+	(*Rsvp)(nil),                        // 25: events_v1.Rsvp
+	(*ListRsvpsResponse)(nil),           // 26: events_v1.ListRsvpsResponse
+	(*SetEventCategoriesRequest)(nil),   // 27: events_v1.SetEventCategoriesRequest
+	(*SuggestEventsRequest)(nil),        // 28: events_v1.SuggestEventsRequest
+	(*Suggestion)(nil),                  // 29: events_v1.Suggestion
+	(*SuggestEventsResponse)(nil),       // 30: events_v1.SuggestEventsResponse
+	(*EventsMapRequest)(nil),            // 31: events_v1.EventsMapRequest
+	(*MapCluster)(nil),                  // 32: events_v1.MapCluster
+	(*EventsMapResponse)(nil),           // 33: events_v1.EventsMapResponse
+	(*CategoryAlias)(nil),               // 34: events_v1.CategoryAlias
+	(*ListCategoryAliasesResponse)(nil), // 35: events_v1.ListCategoryAliasesResponse
+	(*SetCategoryAliasRequest)(nil),     // 36: events_v1.SetCategoryAliasRequest
+	(*DeleteCategoryAliasRequest)(nil),  // 37: events_v1.DeleteCategoryAliasRequest
+	(*ReplayDeadLettersRequest)(nil),    // 38: events_v1.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),   // 39: events_v1.ReplayDeadLettersResponse
+	(*CurrencyRate)(nil),                // 40: events_v1.CurrencyRate
+	(*ListCurrencyRatesResponse)(nil),   // 41: events_v1.ListCurrencyRatesResponse
+	(*ListTrendingEventsRequest)(nil),   // 42: events_v1.ListTrendingEventsRequest
+	(*TrendingEvent)(nil),               // 43: events_v1.TrendingEvent
+	(*ListTrendingEventsResponse)(nil),  // 44: events_v1.ListTrendingEventsResponse
+	(*ExportEventIcsRequest)(nil),       // 45: events_v1.ExportEventIcsRequest
+	(*CalendarFeed)(nil),                // 46: events_v1.CalendarFeed
+	(*GetCalendarFeedIcsRequest)(nil),   // 47: events_v1.GetCalendarFeedIcsRequest
+	(*ReminderSettings)(nil),            // 48: events_v1.ReminderSettings
+	(*GetEventAnalyticsRequest)(nil),    // 49: events_v1.GetEventAnalyticsRequest
+	(*ViewStats)(nil),                   // 50: events_v1.ViewStats
+	(*GetEventAnalyticsResponse)(nil),   // 51: events_v1.GetEventAnalyticsResponse
+	(*ImportCurrencyRatesRequest)(nil),  // 52: events_v1.ImportCurrencyRatesRequest
+	(*ImportCurrencyRatesResponse)(nil), // 53: events_v1.ImportCurrencyRatesResponse
+	(*wrapperspb.StringValue)(nil),      // 54: google.protobuf.StringValue
+	(*wrapperspb.FloatValue)(nil),       // 55: google.protobuf.FloatValue
+	(*wrapperspb.Int32Value)(nil),       // 56: google.protobuf.Int32Value
+	(*timestamppb.Timestamp)(nil),       // 57: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil),      // 58: google.protobuf.DoubleValue
+	(*wrapperspb.Int64Value)(nil),       // 59: google.protobuf.Int64Value
+	(*emptypb.Empty)(nil),               // 60: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),           // 61: google.api.HttpBody
 }
 var file_events_proto_depIdxs = []int32{
-	4,  // 0: events_v1.GetResponse.event:type_name -> events_v1.Event
-	6,  // 1: events_v1.GetResponse.categories:type_name -> events_v1.EventCategory
-	9,  // 2: events_v1.EventAddress.venue_name:type_name -> google.protobuf.StringValue
-	9,  // 3: events_v1.EventAddress.district:type_name -> google.protobuf.StringValue
-	9,  // 4: events_v1.EventAddress.postal_code:type_name -> google.protobuf.StringValue
-	10, // 5: events_v1.EventAddress.latitude:type_name -> google.protobuf.FloatValue
-	10, // 6: events_v1.EventAddress.longitude:type_name -> google.protobuf.FloatValue
-	9,  // 7: events_v1.Event.description:type_name -> google.protobuf.StringValue
-	10, // 8: events_v1.Event.rating:type_name -> google.protobuf.FloatValue
-	11, // 9: events_v1.Event.reviews_count:type_name -> google.protobuf.Int32Value
-	11, // 10: events_v1.Event.ratings_count:type_name -> google.protobuf.Int32Value
-	11, // 11: events_v1.Event.min_age:type_name -> google.protobuf.Int32Value
-	11, // 12: events_v1.Event.seats_available:type_name -> google.protobuf.Int32Value
-	0,  // 13: events_v1.Event.eventType:type_name -> events_v1.EVENT_TYPE
-	11, // 14: events_v1.Event.min_price:type_name -> google.protobuf.Int32Value
-	12, // 15: events_v1.Event.starts_at:type_name -> google.protobuf.Timestamp
-	9,  // 16: events_v1.Event.image_url:type_name -> google.protobuf.StringValue
-	3,  // 17: events_v1.Event.address:type_name -> events_v1.EventAddress
-	12, // 18: events_v1.Event.created_at:type_name -> google.protobuf.Timestamp
-	12, // 19: events_v1.Event.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 20: events_v1.Event.currency:type_name -> google.protobuf.StringValue
-	9,  // 21: events_v1.ListEventsRequest.q:type_name -> google.protobuf.StringValue
-	9,  // 22: events_v1.ListEventsRequest.sort:type_name -> google.protobuf.StringValue
-	9,  // 23: events_v1.ListEventsRequest.city:type_name -> google.protobuf.StringValue
-	9,  // 24: events_v1.ListEventsRequest.district:type_name -> google.protobuf.StringValue
-	11, // 25: events_v1.ListEventsRequest.min_price:type_name -> google.protobuf.Int32Value
-	11, // 26: events_v1.ListEventsRequest.max_price:type_name -> google.protobuf.Int32Value
-	9,  // 27: events_v1.ListEventsRequest.event_date:type_name -> google.protobuf.StringValue
-	0,  // 28: events_v1.ListEventsRequest.event_type:type_name -> events_v1.EVENT_TYPE
-	13, // 29: events_v1.ListEventsRequest.limit:type_name -> google.protobuf.Int64Value
-	13, // 30: events_v1.ListEventsRequest.last_id:type_name -> google.protobuf.Int64Value
-	13, // 31: events_v1.ListEventsRequest.offset:type_name -> google.protobuf.Int64Value
-	11, // 32: events_v1.FiltersValues.min_price:type_name -> google.protobuf.Int32Value
-	11, // 33: events_v1.FiltersValues.max_price:type_name -> google.protobuf.Int32Value
-	6,  // 34: events_v1.FiltersValues.categories:type_name -> events_v1.EventCategory
-	4,  // 35: events_v1.ListEventsResponse.data:type_name -> events_v1.Event
-	7,  // 36: events_v1.ListEventsResponse.filters:type_name -> events_v1.FiltersValues
-	1,  // 37: events_v1.Event_V1.GetEvent:input_type -> events_v1.GetRequest
-	5,  // 38: events_v1.Event_V1.ListEvents:input_type -> events_v1.ListEventsRequest
-	2,  // 39: events_v1.Event_V1.GetEvent:output_type -> events_v1.GetResponse
-	8,  // 40: events_v1.Event_V1.ListEvents:output_type -> events_v1.ListEventsResponse
-	39, // [39:41] is the sub-list for method output_type
-	37, // [37:39] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	0,   // 0: events_v1.GetRequest.source:type_name -> events_v1.VIEW_SOURCE
+	7,   // 1: events_v1.GetResponse.event:type_name -> events_v1.Event
+	11,  // 2: events_v1.GetResponse.categories:type_name -> events_v1.EventCategory
+	54,  // 3: events_v1.EventAddress.venue_name:type_name -> google.protobuf.StringValue
+	54,  // 4: events_v1.EventAddress.district:type_name -> google.protobuf.StringValue
+	54,  // 5: events_v1.EventAddress.postal_code:type_name -> google.protobuf.StringValue
+	55,  // 6: events_v1.EventAddress.latitude:type_name -> google.protobuf.FloatValue
+	55,  // 7: events_v1.EventAddress.longitude:type_name -> google.protobuf.FloatValue
+	54,  // 8: events_v1.Event.description:type_name -> google.protobuf.StringValue
+	55,  // 9: events_v1.Event.rating:type_name -> google.protobuf.FloatValue
+	56,  // 10: events_v1.Event.reviews_count:type_name -> google.protobuf.Int32Value
+	56,  // 11: events_v1.Event.ratings_count:type_name -> google.protobuf.Int32Value
+	56,  // 12: events_v1.Event.min_age:type_name -> google.protobuf.Int32Value
+	56,  // 13: events_v1.Event.seats_available:type_name -> google.protobuf.Int32Value
+	1,   // 14: events_v1.Event.eventType:type_name -> events_v1.EVENT_TYPE
+	56,  // 15: events_v1.Event.min_price:type_name -> google.protobuf.Int32Value
+	57,  // 16: events_v1.Event.starts_at:type_name -> google.protobuf.Timestamp
+	54,  // 17: events_v1.Event.image_url:type_name -> google.protobuf.StringValue
+	6,   // 18: events_v1.Event.address:type_name -> events_v1.EventAddress
+	57,  // 19: events_v1.Event.created_at:type_name -> google.protobuf.Timestamp
+	57,  // 20: events_v1.Event.updated_at:type_name -> google.protobuf.Timestamp
+	54,  // 21: events_v1.Event.currency:type_name -> google.protobuf.StringValue
+	54,  // 22: events_v1.Event.highlight:type_name -> google.protobuf.StringValue
+	58,  // 23: events_v1.Event.distance_m:type_name -> google.protobuf.DoubleValue
+	8,   // 24: events_v1.Event.sessions:type_name -> events_v1.EventSession
+	56,  // 25: events_v1.Event.max_price:type_name -> google.protobuf.Int32Value
+	58,  // 26: events_v1.Event.recommendation_score:type_name -> google.protobuf.DoubleValue
+	57,  // 27: events_v1.EventSession.starts_at:type_name -> google.protobuf.Timestamp
+	57,  // 28: events_v1.EventSession.ends_at:type_name -> google.protobuf.Timestamp
+	56,  // 29: events_v1.EventSession.min_price:type_name -> google.protobuf.Int32Value
+	54,  // 30: events_v1.EventSession.currency:type_name -> google.protobuf.StringValue
+	56,  // 31: events_v1.EventSession.seats_available:type_name -> google.protobuf.Int32Value
+	56,  // 32: events_v1.EventSession.max_price:type_name -> google.protobuf.Int32Value
+	57,  // 33: events_v1.EventSessionInfo.starts_at:type_name -> google.protobuf.Timestamp
+	57,  // 34: events_v1.EventSessionInfo.ends_at:type_name -> google.protobuf.Timestamp
+	56,  // 35: events_v1.EventSessionInfo.min_price:type_name -> google.protobuf.Int32Value
+	54,  // 36: events_v1.EventSessionInfo.currency:type_name -> google.protobuf.StringValue
+	56,  // 37: events_v1.EventSessionInfo.seats_available:type_name -> google.protobuf.Int32Value
+	56,  // 38: events_v1.EventSessionInfo.max_price:type_name -> google.protobuf.Int32Value
+	54,  // 39: events_v1.ListEventsRequest.q:type_name -> google.protobuf.StringValue
+	54,  // 40: events_v1.ListEventsRequest.sort:type_name -> google.protobuf.StringValue
+	54,  // 41: events_v1.ListEventsRequest.city:type_name -> google.protobuf.StringValue
+	54,  // 42: events_v1.ListEventsRequest.district:type_name -> google.protobuf.StringValue
+	56,  // 43: events_v1.ListEventsRequest.min_price:type_name -> google.protobuf.Int32Value
+	56,  // 44: events_v1.ListEventsRequest.max_price:type_name -> google.protobuf.Int32Value
+	54,  // 45: events_v1.ListEventsRequest.event_date:type_name -> google.protobuf.StringValue
+	1,   // 46: events_v1.ListEventsRequest.event_type:type_name -> events_v1.EVENT_TYPE
+	59,  // 47: events_v1.ListEventsRequest.limit:type_name -> google.protobuf.Int64Value
+	58,  // 48: events_v1.ListEventsRequest.lat:type_name -> google.protobuf.DoubleValue
+	58,  // 49: events_v1.ListEventsRequest.lon:type_name -> google.protobuf.DoubleValue
+	58,  // 50: events_v1.ListEventsRequest.radius_km:type_name -> google.protobuf.DoubleValue
+	54,  // 51: events_v1.ListEventsRequest.cursor:type_name -> google.protobuf.StringValue
+	54,  // 52: events_v1.ListEventsRequest.date_from:type_name -> google.protobuf.StringValue
+	54,  // 53: events_v1.ListEventsRequest.date_to:type_name -> google.protobuf.StringValue
+	54,  // 54: events_v1.ListEventsRequest.currency:type_name -> google.protobuf.StringValue
+	56,  // 55: events_v1.FiltersValues.min_price:type_name -> google.protobuf.Int32Value
+	56,  // 56: events_v1.FiltersValues.max_price:type_name -> google.protobuf.Int32Value
+	11,  // 57: events_v1.FiltersValues.categories:type_name -> events_v1.EventCategory
+	7,   // 58: events_v1.ListEventsResponse.data:type_name -> events_v1.Event
+	12,  // 59: events_v1.ListEventsResponse.filters:type_name -> events_v1.FiltersValues
+	54,  // 60: events_v1.ListEventsResponse.next_cursor:type_name -> google.protobuf.StringValue
+	54,  // 61: events_v1.EventInfo.description:type_name -> google.protobuf.StringValue
+	56,  // 62: events_v1.EventInfo.min_age:type_name -> google.protobuf.Int32Value
+	56,  // 63: events_v1.EventInfo.seats_available:type_name -> google.protobuf.Int32Value
+	1,   // 64: events_v1.EventInfo.event_type:type_name -> events_v1.EVENT_TYPE
+	56,  // 65: events_v1.EventInfo.min_price:type_name -> google.protobuf.Int32Value
+	54,  // 66: events_v1.EventInfo.currency:type_name -> google.protobuf.StringValue
+	57,  // 67: events_v1.EventInfo.starts_at:type_name -> google.protobuf.Timestamp
+	54,  // 68: events_v1.EventInfo.image_url:type_name -> google.protobuf.StringValue
+	6,   // 69: events_v1.EventInfo.address:type_name -> events_v1.EventAddress
+	9,   // 70: events_v1.EventInfo.sessions:type_name -> events_v1.EventSessionInfo
+	56,  // 71: events_v1.EventInfo.max_price:type_name -> google.protobuf.Int32Value
+	14,  // 72: events_v1.CreateEventRequest.event:type_name -> events_v1.EventInfo
+	14,  // 73: events_v1.UpdateEventRequest.event:type_name -> events_v1.EventInfo
+	59,  // 74: events_v1.ListFavoritesRequest.limit:type_name -> google.protobuf.Int64Value
+	54,  // 75: events_v1.ListFavoritesRequest.cursor:type_name -> google.protobuf.StringValue
+	7,   // 76: events_v1.ListFavoritesResponse.data:type_name -> events_v1.Event
+	54,  // 77: events_v1.ListFavoritesResponse.next_cursor:type_name -> google.protobuf.StringValue
+	2,   // 78: events_v1.SetRsvpRequest.status:type_name -> events_v1.RSVP_STATUS
+	2,   // 79: events_v1.SetRsvpResponse.status:type_name -> events_v1.RSVP_STATUS
+	2,   // 80: events_v1.ListRsvpsRequest.status:type_name -> events_v1.RSVP_STATUS
+	59,  // 81: events_v1.ListRsvpsRequest.limit:type_name -> google.protobuf.Int64Value
+	54,  // 82: events_v1.ListRsvpsRequest.cursor:type_name -> google.protobuf.StringValue
+	2,   // 83: events_v1.Rsvp.status:type_name -> events_v1.RSVP_STATUS
+	57,  // 84: events_v1.Rsvp.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 85: events_v1.Rsvp.event:type_name -> events_v1.Event
+	25,  // 86: events_v1.ListRsvpsResponse.data:type_name -> events_v1.Rsvp
+	54,  // 87: events_v1.ListRsvpsResponse.next_cursor:type_name -> google.protobuf.StringValue
+	59,  // 88: events_v1.SuggestEventsRequest.limit:type_name -> google.protobuf.Int64Value
+	3,   // 89: events_v1.Suggestion.type:type_name -> events_v1.SUGGESTION_TYPE
+	59,  // 90: events_v1.Suggestion.event_id:type_name -> google.protobuf.Int64Value
+	54,  // 91: events_v1.Suggestion.code:type_name -> google.protobuf.StringValue
+	29,  // 92: events_v1.SuggestEventsResponse.suggestions:type_name -> events_v1.Suggestion
+	54,  // 93: events_v1.EventsMapRequest.q:type_name -> google.protobuf.StringValue
+	54,  // 94: events_v1.EventsMapRequest.city:type_name -> google.protobuf.StringValue
+	54,  // 95: events_v1.EventsMapRequest.district:type_name -> google.protobuf.StringValue
+	56,  // 96: events_v1.EventsMapRequest.min_price:type_name -> google.protobuf.Int32Value
+	56,  // 97: events_v1.EventsMapRequest.max_price:type_name -> google.protobuf.Int32Value
+	54,  // 98: events_v1.EventsMapRequest.event_date:type_name -> google.protobuf.StringValue
+	1,   // 99: events_v1.EventsMapRequest.event_type:type_name -> events_v1.EVENT_TYPE
+	54,  // 100: events_v1.EventsMapRequest.date_from:type_name -> google.protobuf.StringValue
+	54,  // 101: events_v1.EventsMapRequest.date_to:type_name -> google.protobuf.StringValue
+	54,  // 102: events_v1.EventsMapRequest.currency:type_name -> google.protobuf.StringValue
+	32,  // 103: events_v1.EventsMapResponse.clusters:type_name -> events_v1.MapCluster
+	11,  // 104: events_v1.CategoryAlias.category:type_name -> events_v1.EventCategory
+	34,  // 105: events_v1.ListCategoryAliasesResponse.aliases:type_name -> events_v1.CategoryAlias
+	57,  // 106: events_v1.CurrencyRate.updated_at:type_name -> google.protobuf.Timestamp
+	40,  // 107: events_v1.ListCurrencyRatesResponse.rates:type_name -> events_v1.CurrencyRate
+	59,  // 108: events_v1.ListTrendingEventsRequest.limit:type_name -> google.protobuf.Int64Value
+	7,   // 109: events_v1.TrendingEvent.event:type_name -> events_v1.Event
+	43,  // 110: events_v1.ListTrendingEventsResponse.data:type_name -> events_v1.TrendingEvent
+	57,  // 111: events_v1.CalendarFeed.created_at:type_name -> google.protobuf.Timestamp
+	59,  // 112: events_v1.GetEventAnalyticsRequest.event_id:type_name -> google.protobuf.Int64Value
+	54,  // 113: events_v1.GetEventAnalyticsRequest.city:type_name -> google.protobuf.StringValue
+	54,  // 114: events_v1.GetEventAnalyticsRequest.category:type_name -> google.protobuf.StringValue
+	50,  // 115: events_v1.GetEventAnalyticsResponse.total:type_name -> events_v1.ViewStats
+	50,  // 116: events_v1.GetEventAnalyticsResponse.days:type_name -> events_v1.ViewStats
+	4,   // 117: events_v1.Event_V1.GetEvent:input_type -> events_v1.GetRequest
+	10,  // 118: events_v1.Event_V1.ListEvents:input_type -> events_v1.ListEventsRequest
+	31,  // 119: events_v1.Event_V1.GetEventsMap:input_type -> events_v1.EventsMapRequest
+	28,  // 120: events_v1.Event_V1.SuggestEvents:input_type -> events_v1.SuggestEventsRequest
+	19,  // 121: events_v1.Event_V1.AddFavorite:input_type -> events_v1.FavoriteRequest
+	19,  // 122: events_v1.Event_V1.RemoveFavorite:input_type -> events_v1.FavoriteRequest
+	20,  // 123: events_v1.Event_V1.ListFavorites:input_type -> events_v1.ListFavoritesRequest
+	22,  // 124: events_v1.Event_V1.SetRsvp:input_type -> events_v1.SetRsvpRequest
+	24,  // 125: events_v1.Event_V1.ListRsvps:input_type -> events_v1.ListRsvpsRequest
+	15,  // 126: events_v1.Event_V1.CreateEvent:input_type -> events_v1.CreateEventRequest
+	17,  // 127: events_v1.Event_V1.UpdateEvent:input_type -> events_v1.UpdateEventRequest
+	18,  // 128: events_v1.Event_V1.DeleteEvent:input_type -> events_v1.DeleteEventRequest
+	27,  // 129: events_v1.Event_V1.SetEventCategories:input_type -> events_v1.SetEventCategoriesRequest
+	60,  // 130: events_v1.Event_V1.ListCategoryAliases:input_type -> google.protobuf.Empty
+	36,  // 131: events_v1.Event_V1.SetCategoryAlias:input_type -> events_v1.SetCategoryAliasRequest
+	37,  // 132: events_v1.Event_V1.DeleteCategoryAlias:input_type -> events_v1.DeleteCategoryAliasRequest
+	38,  // 133: events_v1.Event_V1.ReplayDeadLetters:input_type -> events_v1.ReplayDeadLettersRequest
+	60,  // 134: events_v1.Event_V1.ListCurrencyRates:input_type -> google.protobuf.Empty
+	52,  // 135: events_v1.Event_V1.ImportCurrencyRates:input_type -> events_v1.ImportCurrencyRatesRequest
+	42,  // 136: events_v1.Event_V1.ListTrendingEvents:input_type -> events_v1.ListTrendingEventsRequest
+	49,  // 137: events_v1.Event_V1.GetEventAnalytics:input_type -> events_v1.GetEventAnalyticsRequest
+	45,  // 138: events_v1.Event_V1.ExportEventIcs:input_type -> events_v1.ExportEventIcsRequest
+	60,  // 139: events_v1.Event_V1.GetCalendarFeed:input_type -> google.protobuf.Empty
+	60,  // 140: events_v1.Event_V1.ResetCalendarFeed:input_type -> google.protobuf.Empty
+	47,  // 141: events_v1.Event_V1.GetCalendarFeedIcs:input_type -> events_v1.GetCalendarFeedIcsRequest
+	60,  // 142: events_v1.Event_V1.GetReminderSettings:input_type -> google.protobuf.Empty
+	48,  // 143: events_v1.Event_V1.UpdateReminderSettings:input_type -> events_v1.ReminderSettings
+	5,   // 144: events_v1.Event_V1.GetEvent:output_type -> events_v1.GetResponse
+	13,  // 145: events_v1.Event_V1.ListEvents:output_type -> events_v1.ListEventsResponse
+	33,  // 146: events_v1.Event_V1.GetEventsMap:output_type -> events_v1.EventsMapResponse
+	30,  // 147: events_v1.Event_V1.SuggestEvents:output_type -> events_v1.SuggestEventsResponse
+	60,  // 148: events_v1.Event_V1.AddFavorite:output_type -> google.protobuf.Empty
+	60,  // 149: events_v1.Event_V1.RemoveFavorite:output_type -> google.protobuf.Empty
+	21,  // 150: events_v1.Event_V1.ListFavorites:output_type -> events_v1.ListFavoritesResponse
+	23,  // 151: events_v1.Event_V1.SetRsvp:output_type -> events_v1.SetRsvpResponse
+	26,  // 152: events_v1.Event_V1.ListRsvps:output_type -> events_v1.ListRsvpsResponse
+	16,  // 153: events_v1.Event_V1.CreateEvent:output_type -> events_v1.CreateEventResponse
+	60,  // 154: events_v1.Event_V1.UpdateEvent:output_type -> google.protobuf.Empty
+	60,  // 155: events_v1.Event_V1.DeleteEvent:output_type -> google.protobuf.Empty
+	60,  // 156: events_v1.Event_V1.SetEventCategories:output_type -> google.protobuf.Empty
+	35,  // 157: events_v1.Event_V1.ListCategoryAliases:output_type -> events_v1.ListCategoryAliasesResponse
+	60,  // 158: events_v1.Event_V1.SetCategoryAlias:output_type -> google.protobuf.Empty
+	60,  // 159: events_v1.Event_V1.DeleteCategoryAlias:output_type -> google.protobuf.Empty
+	39,  // 160: events_v1.Event_V1.ReplayDeadLetters:output_type -> events_v1.ReplayDeadLettersResponse
+	41,  // 161: events_v1.Event_V1.ListCurrencyRates:output_type -> events_v1.ListCurrencyRatesResponse
+	53,  // 162: events_v1.Event_V1.ImportCurrencyRates:output_type -> events_v1.ImportCurrencyRatesResponse
+	44,  // 163: events_v1.Event_V1.ListTrendingEvents:output_type -> events_v1.ListTrendingEventsResponse
+	51,  // 164: events_v1.Event_V1.GetEventAnalytics:output_type -> events_v1.GetEventAnalyticsResponse
+	61,  // 165: events_v1.Event_V1.ExportEventIcs:output_type -> google.api.HttpBody
+	46,  // 166: events_v1.Event_V1.GetCalendarFeed:output_type -> events_v1.CalendarFeed
+	46,  // 167: events_v1.Event_V1.ResetCalendarFeed:output_type -> events_v1.CalendarFeed
+	61,  // 168: events_v1.Event_V1.GetCalendarFeedIcs:output_type -> google.api.HttpBody
+	48,  // 169: events_v1.Event_V1.GetReminderSettings:output_type -> events_v1.ReminderSettings
+	48,  // 170: events_v1.Event_V1.UpdateReminderSettings:output_type -> events_v1.ReminderSettings
+	144, // [144:171] is the sub-list for method output_type
+	117, // [117:144] is the sub-list for method input_type
+	117, // [117:117] is the sub-list for extension type_name
+	117, // [117:117] is the sub-list for extension extendee
+	0,   // [0:117] is the sub-list for field type_name
 }
 
 func init() { file_events_proto_init() }
@@ -919,14 +4223,15 @@ func file_events_proto_init() {
 		return
 	}
 	file_events_proto_msgTypes[3].OneofWrappers = []any{}
-	file_events_proto_msgTypes[4].OneofWrappers = []any{}
+	file_events_proto_msgTypes[6].OneofWrappers = []any{}
+	file_events_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      4,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -59,6 +59,17 @@ func (m *GetRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if _, ok := VIEW_SOURCE_name[int32(m.GetSource())]; !ok {
+		err := GetRequestValidationError{
+			field:  "Source",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetRequestMultiError(errors)
 	}
@@ -925,6 +936,164 @@ func (m *Event) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetHighlight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Highlight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Highlight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHighlight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Highlight",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDistanceM()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "DistanceM",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "DistanceM",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDistanceM()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "DistanceM",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsFree
+
+	// no validation rules for FavoritesCount
+
+	// no validation rules for IsFavorite
+
+	// no validation rules for GoingCount
+
+	if all {
+		switch v := interface{}(m.GetRecommendationScore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "RecommendationScore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "RecommendationScore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecommendationScore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "RecommendationScore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Address != nil {
 
 		if all {
@@ -1035,51 +1204,53 @@ var _ interface {
 	ErrorName() string
 } = EventValidationError{}
 
-// Validate checks the field values on ListEventsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListEventsRequest) Validate() error {
+// Validate checks the field values on EventSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventSession) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEventsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListEventsRequestMultiError, or nil if none found.
-func (m *ListEventsRequest) ValidateAll() error {
+// ValidateAll checks the field values on EventSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventSessionMultiError, or
+// nil if none found.
+func (m *EventSession) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEventsRequest) validate(all bool) error {
+func (m *EventSession) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if all {
-		switch v := interface{}(m.GetQ()).(type) {
+		switch v := interface{}(m.GetStartsAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Q",
+				errors = append(errors, EventSessionValidationError{
+					field:  "StartsAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Q",
+				errors = append(errors, EventSessionValidationError{
+					field:  "StartsAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetQ()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "Q",
+			return EventSessionValidationError{
+				field:  "StartsAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1087,28 +1258,28 @@ func (m *ListEventsRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetSort()).(type) {
+		switch v := interface{}(m.GetEndsAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Sort",
+				errors = append(errors, EventSessionValidationError{
+					field:  "EndsAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Sort",
+				errors = append(errors, EventSessionValidationError{
+					field:  "EndsAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSort()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "Sort",
+			return EventSessionValidationError{
+				field:  "EndsAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1116,28 +1287,28 @@ func (m *ListEventsRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetCity()).(type) {
+		switch v := interface{}(m.GetMinPrice()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "City",
+				errors = append(errors, EventSessionValidationError{
+					field:  "MinPrice",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "City",
+				errors = append(errors, EventSessionValidationError{
+					field:  "MinPrice",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "City",
+			return EventSessionValidationError{
+				field:  "MinPrice",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1145,28 +1316,28 @@ func (m *ListEventsRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDistrict()).(type) {
+		switch v := interface{}(m.GetCurrency()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "District",
+				errors = append(errors, EventSessionValidationError{
+					field:  "Currency",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "District",
+				errors = append(errors, EventSessionValidationError{
+					field:  "Currency",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDistrict()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCurrency()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "District",
+			return EventSessionValidationError{
+				field:  "Currency",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1174,28 +1345,28 @@ func (m *ListEventsRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetMinPrice()).(type) {
+		switch v := interface{}(m.GetSeatsAvailable()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "MinPrice",
+				errors = append(errors, EventSessionValidationError{
+					field:  "SeatsAvailable",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "MinPrice",
+				errors = append(errors, EventSessionValidationError{
+					field:  "SeatsAvailable",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSeatsAvailable()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "MinPrice",
+			return EventSessionValidationError{
+				field:  "SeatsAvailable",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1206,7 +1377,7 @@ func (m *ListEventsRequest) validate(all bool) error {
 		switch v := interface{}(m.GetMaxPrice()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
+				errors = append(errors, EventSessionValidationError{
 					field:  "MaxPrice",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1214,7 +1385,7 @@ func (m *ListEventsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
+				errors = append(errors, EventSessionValidationError{
 					field:  "MaxPrice",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1223,7 +1394,7 @@ func (m *ListEventsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
+			return EventSessionValidationError{
 				field:  "MaxPrice",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1231,58 +1402,6666 @@ func (m *ListEventsRequest) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetEventDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "EventDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "EventDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEventDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "EventDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return EventSessionMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetLimit()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Limit",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	return nil
+}
+
+// EventSessionMultiError is an error wrapping multiple validation errors
+// returned by EventSession.ValidateAll() if the designated constraints aren't met.
+type EventSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventSessionMultiError) AllErrors() []error { return m }
+
+// EventSessionValidationError is the validation error returned by
+// EventSession.Validate if the designated constraints aren't met.
+type EventSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventSessionValidationError) ErrorName() string { return "EventSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventSessionValidationError{}
+
+// Validate checks the field values on EventSessionInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EventSessionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventSessionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventSessionInfoMultiError, or nil if none found.
+func (m *EventSessionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventSessionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStartsAt() == nil {
+		err := EventSessionInfoValidationError{
+			field:  "StartsAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventSessionInfoValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventSessionInfoValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventSessionInfoValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCurrency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "Currency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "Currency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCurrency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventSessionInfoValidationError{
+				field:  "Currency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSeatsAvailable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "SeatsAvailable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "SeatsAvailable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeatsAvailable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventSessionInfoValidationError{
+				field:  "SeatsAvailable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventSessionInfoValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventSessionInfoValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EventSessionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventSessionInfoMultiError is an error wrapping multiple validation errors
+// returned by EventSessionInfo.ValidateAll() if the designated constraints
+// aren't met.
+type EventSessionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventSessionInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventSessionInfoMultiError) AllErrors() []error { return m }
+
+// EventSessionInfoValidationError is the validation error returned by
+// EventSessionInfo.Validate if the designated constraints aren't met.
+type EventSessionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventSessionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventSessionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventSessionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventSessionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventSessionInfoValidationError) ErrorName() string {
+	return "EventSessionInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventSessionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventSessionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventSessionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventSessionInfoValidationError{}
+
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsRequestMultiError, or nil if none found.
+func (m *ListEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQ()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Q",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Q",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQ()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Q",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSort()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSort()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Sort",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "City",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDistrict()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "District",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "District",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDistrict()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "District",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEventDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "EventDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "EventDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "EventDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Limit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Lat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Lat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Lat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Lon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Lon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Lon",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRadiusKm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "RadiusKm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "RadiusKm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRadiusKm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "RadiusKm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Cursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDateFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "DateFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "DateFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "DateFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDateTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "DateTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCurrency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Currency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Currency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCurrency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Currency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.EventType != nil {
+		// no validation rules for EventType
+	}
+
+	if len(errors) > 0 {
+		return ListEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestValidationError{}
+
+// Validate checks the field values on EventCategory with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventCategory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventCategory with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventCategoryMultiError, or
+// nil if none found.
+func (m *EventCategory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventCategory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return EventCategoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventCategoryMultiError is an error wrapping multiple validation errors
+// returned by EventCategory.ValidateAll() if the designated constraints
+// aren't met.
+type EventCategoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventCategoryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventCategoryMultiError) AllErrors() []error { return m }
+
+// EventCategoryValidationError is the validation error returned by
+// EventCategory.Validate if the designated constraints aren't met.
+type EventCategoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventCategoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventCategoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventCategoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventCategoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventCategoryValidationError) ErrorName() string { return "EventCategoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventCategoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventCategory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventCategoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventCategoryValidationError{}
+
+// Validate checks the field values on FiltersValues with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FiltersValues) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FiltersValues with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FiltersValuesMultiError, or
+// nil if none found.
+func (m *FiltersValues) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FiltersValues) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FiltersValuesValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FiltersValuesValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FiltersValuesValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FiltersValuesValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FiltersValuesValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FiltersValuesValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FiltersValuesValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FiltersValuesValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FiltersValuesValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return FiltersValuesMultiError(errors)
+	}
+
+	return nil
+}
+
+// FiltersValuesMultiError is an error wrapping multiple validation errors
+// returned by FiltersValues.ValidateAll() if the designated constraints
+// aren't met.
+type FiltersValuesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FiltersValuesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FiltersValuesMultiError) AllErrors() []error { return m }
+
+// FiltersValuesValidationError is the validation error returned by
+// FiltersValues.Validate if the designated constraints aren't met.
+type FiltersValuesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FiltersValuesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FiltersValuesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FiltersValuesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FiltersValuesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FiltersValuesValidationError) ErrorName() string { return "FiltersValuesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FiltersValuesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFiltersValues.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FiltersValuesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FiltersValuesValidationError{}
+
+// Validate checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsResponseMultiError, or nil if none found.
+func (m *ListEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsResponseValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsResponseValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsResponseValidationError{
+				field:  "Filters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsResponseValidationError{
+				field:  "NextCursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListEventsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListEventsResponseValidationError is the validation error returned by
+// ListEventsResponse.Validate if the designated constraints aren't met.
+type ListEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsResponseValidationError) ErrorName() string {
+	return "ListEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsResponseValidationError{}
+
+// Validate checks the field values on EventInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventInfoMultiError, or nil
+// if none found.
+func (m *EventInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
+		err := EventInfoValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDescription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "Description",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "Description",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDescription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "Description",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetLink()); l < 1 || l > 255 {
+		err := EventInfoValidationError{
+			field:  "Link",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMinAge()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "MinAge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "MinAge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinAge()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "MinAge",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSeatsAvailable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "SeatsAvailable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "SeatsAvailable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeatsAvailable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "SeatsAvailable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := EVENT_TYPE_name[int32(m.GetEventType())]; !ok {
+		err := EventInfoValidationError{
+			field:  "EventType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCurrency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "Currency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "Currency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCurrency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "Currency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetStartsAt() == nil {
+		err := EventInfoValidationError{
+			field:  "StartsAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetImageUrl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "ImageUrl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "ImageUrl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImageUrl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "ImageUrl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAddress() == nil {
+		err := EventInfoValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetSessions()) > 500 {
+		err := EventInfoValidationError{
+			field:  "Sessions",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventInfoValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventInfoValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventInfoValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventInfoValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventInfoValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsFree
+
+	if len(errors) > 0 {
+		return EventInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventInfoMultiError is an error wrapping multiple validation errors returned
+// by EventInfo.ValidateAll() if the designated constraints aren't met.
+type EventInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventInfoMultiError) AllErrors() []error { return m }
+
+// EventInfoValidationError is the validation error returned by
+// EventInfo.Validate if the designated constraints aren't met.
+type EventInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventInfoValidationError) ErrorName() string { return "EventInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventInfoValidationError{}
+
+// Validate checks the field values on CreateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateEventRequestMultiError, or nil if none found.
+func (m *CreateEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEvent() == nil {
+		err := CreateEventRequestValidationError{
+			field:  "Event",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateEventRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateEventRequestMultiError) AllErrors() []error { return m }
+
+// CreateEventRequestValidationError is the validation error returned by
+// CreateEventRequest.Validate if the designated constraints aren't met.
+type CreateEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEventRequestValidationError) ErrorName() string {
+	return "CreateEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEventRequestValidationError{}
+
+// Validate checks the field values on CreateEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateEventResponseMultiError, or nil if none found.
+func (m *CreateEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateEventResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateEventResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateEventResponseMultiError) AllErrors() []error { return m }
+
+// CreateEventResponseValidationError is the validation error returned by
+// CreateEventResponse.Validate if the designated constraints aren't met.
+type CreateEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEventResponseValidationError) ErrorName() string {
+	return "CreateEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEventResponseValidationError{}
+
+// Validate checks the field values on UpdateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateEventRequestMultiError, or nil if none found.
+func (m *UpdateEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateEventRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEvent() == nil {
+		err := UpdateEventRequestValidationError{
+			field:  "Event",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateEventRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateEventRequestMultiError) AllErrors() []error { return m }
+
+// UpdateEventRequestValidationError is the validation error returned by
+// UpdateEventRequest.Validate if the designated constraints aren't met.
+type UpdateEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateEventRequestValidationError) ErrorName() string {
+	return "UpdateEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateEventRequestValidationError{}
+
+// Validate checks the field values on DeleteEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteEventRequestMultiError, or nil if none found.
+func (m *DeleteEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteEventRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteEventRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteEventRequestMultiError) AllErrors() []error { return m }
+
+// DeleteEventRequestValidationError is the validation error returned by
+// DeleteEventRequest.Validate if the designated constraints aren't met.
+type DeleteEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteEventRequestValidationError) ErrorName() string {
+	return "DeleteEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteEventRequestValidationError{}
+
+// Validate checks the field values on FavoriteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FavoriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FavoriteRequestMultiError, or nil if none found.
+func (m *FavoriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FavoriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEventId() <= 0 {
+		err := FavoriteRequestValidationError{
+			field:  "EventId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FavoriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavoriteRequestMultiError is an error wrapping multiple validation errors
+// returned by FavoriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FavoriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavoriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavoriteRequestMultiError) AllErrors() []error { return m }
+
+// FavoriteRequestValidationError is the validation error returned by
+// FavoriteRequest.Validate if the designated constraints aren't met.
+type FavoriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavoriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavoriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavoriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavoriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavoriteRequestValidationError) ErrorName() string { return "FavoriteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FavoriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavoriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavoriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavoriteRequestValidationError{}
+
+// Validate checks the field values on ListFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFavoritesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFavoritesRequestMultiError, or nil if none found.
+func (m *ListFavoritesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFavoritesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludePast
+
+	if all {
+		switch v := interface{}(m.GetLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFavoritesRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFavoritesRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFavoritesRequestValidationError{
+				field:  "Limit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFavoritesRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFavoritesRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFavoritesRequestValidationError{
+				field:  "Cursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListFavoritesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFavoritesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFavoritesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFavoritesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFavoritesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFavoritesRequestMultiError) AllErrors() []error { return m }
+
+// ListFavoritesRequestValidationError is the validation error returned by
+// ListFavoritesRequest.Validate if the designated constraints aren't met.
+type ListFavoritesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFavoritesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFavoritesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFavoritesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFavoritesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFavoritesRequestValidationError) ErrorName() string {
+	return "ListFavoritesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFavoritesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFavoritesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFavoritesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFavoritesRequestValidationError{}
+
+// Validate checks the field values on ListFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFavoritesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFavoritesResponseMultiError, or nil if none found.
+func (m *ListFavoritesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFavoritesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFavoritesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFavoritesResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFavoritesResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFavoritesResponseValidationError{
+				field:  "NextCursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListFavoritesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFavoritesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFavoritesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFavoritesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFavoritesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFavoritesResponseMultiError) AllErrors() []error { return m }
+
+// ListFavoritesResponseValidationError is the validation error returned by
+// ListFavoritesResponse.Validate if the designated constraints aren't met.
+type ListFavoritesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFavoritesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFavoritesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFavoritesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFavoritesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFavoritesResponseValidationError) ErrorName() string {
+	return "ListFavoritesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFavoritesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFavoritesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFavoritesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFavoritesResponseValidationError{}
+
+// Validate checks the field values on SetRsvpRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetRsvpRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetRsvpRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SetRsvpRequestMultiError,
+// or nil if none found.
+func (m *SetRsvpRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetRsvpRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEventId() <= 0 {
+		err := SetRsvpRequestValidationError{
+			field:  "EventId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RSVP_STATUS_name[int32(m.GetStatus())]; !ok {
+		err := SetRsvpRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetRsvpRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetRsvpRequestMultiError is an error wrapping multiple validation errors
+// returned by SetRsvpRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetRsvpRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetRsvpRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetRsvpRequestMultiError) AllErrors() []error { return m }
+
+// SetRsvpRequestValidationError is the validation error returned by
+// SetRsvpRequest.Validate if the designated constraints aren't met.
+type SetRsvpRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRsvpRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRsvpRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRsvpRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRsvpRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRsvpRequestValidationError) ErrorName() string { return "SetRsvpRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetRsvpRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRsvpRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRsvpRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRsvpRequestValidationError{}
+
+// Validate checks the field values on SetRsvpResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetRsvpResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetRsvpResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetRsvpResponseMultiError, or nil if none found.
+func (m *SetRsvpResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetRsvpResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return SetRsvpResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetRsvpResponseMultiError is an error wrapping multiple validation errors
+// returned by SetRsvpResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SetRsvpResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetRsvpResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetRsvpResponseMultiError) AllErrors() []error { return m }
+
+// SetRsvpResponseValidationError is the validation error returned by
+// SetRsvpResponse.Validate if the designated constraints aren't met.
+type SetRsvpResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRsvpResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRsvpResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRsvpResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRsvpResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRsvpResponseValidationError) ErrorName() string { return "SetRsvpResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetRsvpResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRsvpResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRsvpResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRsvpResponseValidationError{}
+
+// Validate checks the field values on ListRsvpsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRsvpsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRsvpsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRsvpsRequestMultiError, or nil if none found.
+func (m *ListRsvpsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRsvpsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRsvpsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRsvpsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRsvpsRequestValidationError{
+				field:  "Limit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRsvpsRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRsvpsRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRsvpsRequestValidationError{
+				field:  "Cursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListRsvpsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRsvpsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRsvpsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRsvpsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRsvpsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRsvpsRequestMultiError) AllErrors() []error { return m }
+
+// ListRsvpsRequestValidationError is the validation error returned by
+// ListRsvpsRequest.Validate if the designated constraints aren't met.
+type ListRsvpsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRsvpsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRsvpsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRsvpsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRsvpsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRsvpsRequestValidationError) ErrorName() string {
+	return "ListRsvpsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRsvpsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRsvpsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRsvpsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRsvpsRequestValidationError{}
+
+// Validate checks the field values on Rsvp with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Rsvp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Rsvp with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RsvpMultiError, or nil if none found.
+func (m *Rsvp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Rsvp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RsvpValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RsvpValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RsvpValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RsvpValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RsvpValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RsvpValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RsvpMultiError(errors)
+	}
+
+	return nil
+}
+
+// RsvpMultiError is an error wrapping multiple validation errors returned by
+// Rsvp.ValidateAll() if the designated constraints aren't met.
+type RsvpMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RsvpMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RsvpMultiError) AllErrors() []error { return m }
+
+// RsvpValidationError is the validation error returned by Rsvp.Validate if the
+// designated constraints aren't met.
+type RsvpValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RsvpValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RsvpValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RsvpValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RsvpValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RsvpValidationError) ErrorName() string { return "RsvpValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RsvpValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRsvp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RsvpValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RsvpValidationError{}
+
+// Validate checks the field values on ListRsvpsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRsvpsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRsvpsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRsvpsResponseMultiError, or nil if none found.
+func (m *ListRsvpsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRsvpsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRsvpsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRsvpsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRsvpsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRsvpsResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRsvpsResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRsvpsResponseValidationError{
+				field:  "NextCursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListRsvpsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRsvpsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRsvpsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRsvpsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRsvpsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRsvpsResponseMultiError) AllErrors() []error { return m }
+
+// ListRsvpsResponseValidationError is the validation error returned by
+// ListRsvpsResponse.Validate if the designated constraints aren't met.
+type ListRsvpsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRsvpsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRsvpsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRsvpsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRsvpsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRsvpsResponseValidationError) ErrorName() string {
+	return "ListRsvpsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRsvpsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRsvpsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRsvpsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRsvpsResponseValidationError{}
+
+// Validate checks the field values on SetEventCategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetEventCategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetEventCategoriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetEventCategoriesRequestMultiError, or nil if none found.
+func (m *SetEventCategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetEventCategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := SetEventCategoriesRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetEventCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetEventCategoriesRequestMultiError is an error wrapping multiple validation
+// errors returned by SetEventCategoriesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetEventCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetEventCategoriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetEventCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// SetEventCategoriesRequestValidationError is the validation error returned by
+// SetEventCategoriesRequest.Validate if the designated constraints aren't met.
+type SetEventCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEventCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEventCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEventCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEventCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEventCategoriesRequestValidationError) ErrorName() string {
+	return "SetEventCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEventCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEventCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEventCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEventCategoriesRequestValidationError{}
+
+// Validate checks the field values on SuggestEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestEventsRequestMultiError, or nil if none found.
+func (m *SuggestEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQ()); l < 1 || l > 100 {
+		err := SuggestEventsRequestValidationError{
+			field:  "Q",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuggestEventsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuggestEventsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuggestEventsRequestValidationError{
+				field:  "Limit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SuggestEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by SuggestEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestEventsRequestMultiError) AllErrors() []error { return m }
+
+// SuggestEventsRequestValidationError is the validation error returned by
+// SuggestEventsRequest.Validate if the designated constraints aren't met.
+type SuggestEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestEventsRequestValidationError) ErrorName() string {
+	return "SuggestEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestEventsRequestValidationError{}
+
+// Validate checks the field values on Suggestion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Suggestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Suggestion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SuggestionMultiError, or
+// nil if none found.
+func (m *Suggestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Suggestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Text
+
+	if all {
+		switch v := interface{}(m.GetEventId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuggestionValidationError{
+					field:  "EventId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuggestionValidationError{
+					field:  "EventId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuggestionValidationError{
+				field:  "EventId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuggestionValidationError{
+					field:  "Code",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuggestionValidationError{
+					field:  "Code",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuggestionValidationError{
+				field:  "Code",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return SuggestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestionMultiError is an error wrapping multiple validation errors
+// returned by Suggestion.ValidateAll() if the designated constraints aren't met.
+type SuggestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestionMultiError) AllErrors() []error { return m }
+
+// SuggestionValidationError is the validation error returned by
+// Suggestion.Validate if the designated constraints aren't met.
+type SuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestionValidationError) ErrorName() string { return "SuggestionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestionValidationError{}
+
+// Validate checks the field values on SuggestEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestEventsResponseMultiError, or nil if none found.
+func (m *SuggestEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSuggestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SuggestEventsResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SuggestEventsResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestEventsResponseValidationError{
+					field:  fmt.Sprintf("Suggestions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SuggestEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by SuggestEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestEventsResponseMultiError) AllErrors() []error { return m }
+
+// SuggestEventsResponseValidationError is the validation error returned by
+// SuggestEventsResponse.Validate if the designated constraints aren't met.
+type SuggestEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestEventsResponseValidationError) ErrorName() string {
+	return "SuggestEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestEventsResponseValidationError{}
+
+// Validate checks the field values on EventsMapRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EventsMapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventsMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventsMapRequestMultiError, or nil if none found.
+func (m *EventsMapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventsMapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetMinLat(); val < -90 || val > 90 {
+		err := EventsMapRequestValidationError{
+			field:  "MinLat",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinLon(); val < -180 || val > 180 {
+		err := EventsMapRequestValidationError{
+			field:  "MinLon",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxLat(); val < -90 || val > 90 {
+		err := EventsMapRequestValidationError{
+			field:  "MaxLat",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxLon(); val < -180 || val > 180 {
+		err := EventsMapRequestValidationError{
+			field:  "MaxLon",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetZoom(); val < 0 || val > 22 {
+		err := EventsMapRequestValidationError{
+			field:  "Zoom",
+			reason: "value must be inside range [0, 22]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetQ()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "Q",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "Q",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQ()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "Q",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "City",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDistrict()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "District",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "District",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDistrict()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "District",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMinPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "MinPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "MinPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "MaxPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "MaxPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEventDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "EventDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "EventDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "EventDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDateFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "DateFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "DateFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "DateFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDateTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "DateTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCurrency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "Currency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventsMapRequestValidationError{
+					field:  "Currency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCurrency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventsMapRequestValidationError{
+				field:  "Currency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.EventType != nil {
+		// no validation rules for EventType
+	}
+
+	if len(errors) > 0 {
+		return EventsMapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventsMapRequestMultiError is an error wrapping multiple validation errors
+// returned by EventsMapRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EventsMapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventsMapRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventsMapRequestMultiError) AllErrors() []error { return m }
+
+// EventsMapRequestValidationError is the validation error returned by
+// EventsMapRequest.Validate if the designated constraints aren't met.
+type EventsMapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventsMapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventsMapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventsMapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventsMapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventsMapRequestValidationError) ErrorName() string {
+	return "EventsMapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventsMapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventsMapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventsMapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventsMapRequestValidationError{}
+
+// Validate checks the field values on MapCluster with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MapCluster) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MapCluster with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MapClusterMultiError, or
+// nil if none found.
+func (m *MapCluster) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MapCluster) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	// no validation rules for Latitude
+
+	// no validation rules for Longitude
+
+	if len(errors) > 0 {
+		return MapClusterMultiError(errors)
+	}
+
+	return nil
+}
+
+// MapClusterMultiError is an error wrapping multiple validation errors
+// returned by MapCluster.ValidateAll() if the designated constraints aren't met.
+type MapClusterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MapClusterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MapClusterMultiError) AllErrors() []error { return m }
+
+// MapClusterValidationError is the validation error returned by
+// MapCluster.Validate if the designated constraints aren't met.
+type MapClusterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MapClusterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MapClusterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MapClusterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MapClusterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MapClusterValidationError) ErrorName() string { return "MapClusterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MapClusterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMapCluster.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MapClusterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MapClusterValidationError{}
+
+// Validate checks the field values on EventsMapResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EventsMapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventsMapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventsMapResponseMultiError, or nil if none found.
+func (m *EventsMapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventsMapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClusters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventsMapResponseValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventsMapResponseValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventsMapResponseValidationError{
+					field:  fmt.Sprintf("Clusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EventsMapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventsMapResponseMultiError is an error wrapping multiple validation errors
+// returned by EventsMapResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EventsMapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventsMapResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventsMapResponseMultiError) AllErrors() []error { return m }
+
+// EventsMapResponseValidationError is the validation error returned by
+// EventsMapResponse.Validate if the designated constraints aren't met.
+type EventsMapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventsMapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventsMapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventsMapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventsMapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventsMapResponseValidationError) ErrorName() string {
+	return "EventsMapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventsMapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventsMapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventsMapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventsMapResponseValidationError{}
+
+// Validate checks the field values on CategoryAlias with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryAlias) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryAlias with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryAliasMultiError, or
+// nil if none found.
+func (m *CategoryAlias) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryAlias) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Alias
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryAliasValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryAliasValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryAliasValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CategoryAliasMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryAliasMultiError is an error wrapping multiple validation errors
+// returned by CategoryAlias.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryAliasMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryAliasMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryAliasMultiError) AllErrors() []error { return m }
+
+// CategoryAliasValidationError is the validation error returned by
+// CategoryAlias.Validate if the designated constraints aren't met.
+type CategoryAliasValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryAliasValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryAliasValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryAliasValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryAliasValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryAliasValidationError) ErrorName() string { return "CategoryAliasValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryAliasValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryAlias.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryAliasValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryAliasValidationError{}
+
+// Validate checks the field values on ListCategoryAliasesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCategoryAliasesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCategoryAliasesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCategoryAliasesResponseMultiError, or nil if none found.
+func (m *ListCategoryAliasesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCategoryAliasesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAliases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCategoryAliasesResponseValidationError{
+						field:  fmt.Sprintf("Aliases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCategoryAliasesResponseValidationError{
+						field:  fmt.Sprintf("Aliases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCategoryAliasesResponseValidationError{
+					field:  fmt.Sprintf("Aliases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCategoryAliasesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCategoryAliasesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCategoryAliasesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListCategoryAliasesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCategoryAliasesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCategoryAliasesResponseMultiError) AllErrors() []error { return m }
+
+// ListCategoryAliasesResponseValidationError is the validation error returned
+// by ListCategoryAliasesResponse.Validate if the designated constraints
+// aren't met.
+type ListCategoryAliasesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCategoryAliasesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCategoryAliasesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCategoryAliasesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCategoryAliasesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCategoryAliasesResponseValidationError) ErrorName() string {
+	return "ListCategoryAliasesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCategoryAliasesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCategoryAliasesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCategoryAliasesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCategoryAliasesResponseValidationError{}
+
+// Validate checks the field values on SetCategoryAliasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetCategoryAliasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetCategoryAliasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetCategoryAliasRequestMultiError, or nil if none found.
+func (m *SetCategoryAliasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetCategoryAliasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAlias()); l < 1 || l > 255 {
+		err := SetCategoryAliasRequestValidationError{
+			field:  "Alias",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCategoryCode()); l < 1 || l > 255 {
+		err := SetCategoryAliasRequestValidationError{
+			field:  "CategoryCode",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetCategoryAliasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetCategoryAliasRequestMultiError is an error wrapping multiple validation
+// errors returned by SetCategoryAliasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetCategoryAliasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetCategoryAliasRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetCategoryAliasRequestMultiError) AllErrors() []error { return m }
+
+// SetCategoryAliasRequestValidationError is the validation error returned by
+// SetCategoryAliasRequest.Validate if the designated constraints aren't met.
+type SetCategoryAliasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetCategoryAliasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetCategoryAliasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetCategoryAliasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetCategoryAliasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetCategoryAliasRequestValidationError) ErrorName() string {
+	return "SetCategoryAliasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetCategoryAliasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetCategoryAliasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetCategoryAliasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetCategoryAliasRequestValidationError{}
+
+// Validate checks the field values on DeleteCategoryAliasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCategoryAliasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCategoryAliasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCategoryAliasRequestMultiError, or nil if none found.
+func (m *DeleteCategoryAliasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCategoryAliasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAlias()); l < 1 || l > 255 {
+		err := DeleteCategoryAliasRequestValidationError{
+			field:  "Alias",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCategoryAliasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCategoryAliasRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteCategoryAliasRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteCategoryAliasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCategoryAliasRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCategoryAliasRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCategoryAliasRequestValidationError is the validation error returned
+// by DeleteCategoryAliasRequest.Validate if the designated constraints aren't met.
+type DeleteCategoryAliasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCategoryAliasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCategoryAliasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCategoryAliasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCategoryAliasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCategoryAliasRequestValidationError) ErrorName() string {
+	return "DeleteCategoryAliasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCategoryAliasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCategoryAliasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCategoryAliasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCategoryAliasRequestValidationError{}
+
+// Validate checks the field values on ReplayDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLettersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLettersRequestMultiError, or nil if none found.
+func (m *ReplayDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := ReplayDeadLettersRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReplayDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLettersRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLettersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLettersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLettersRequestValidationError is the validation error returned by
+// ReplayDeadLettersRequest.Validate if the designated constraints aren't met.
+type ReplayDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLettersRequestValidationError) ErrorName() string {
+	return "ReplayDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLettersRequestValidationError{}
+
+// Validate checks the field values on ReplayDeadLettersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLettersResponseMultiError, or nil if none found.
+func (m *ReplayDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Replayed
+
+	// no validation rules for Skipped
+
+	if len(errors) > 0 {
+		return ReplayDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLettersResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLettersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReplayDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLettersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLettersResponseValidationError is the validation error returned by
+// ReplayDeadLettersResponse.Validate if the designated constraints aren't met.
+type ReplayDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLettersResponseValidationError) ErrorName() string {
+	return "ReplayDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLettersResponseValidationError{}
+
+// Validate checks the field values on CurrencyRate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CurrencyRate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CurrencyRate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CurrencyRateMultiError, or
+// nil if none found.
+func (m *CurrencyRate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CurrencyRate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Currency
+
+	// no validation rules for Rate
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CurrencyRateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CurrencyRateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CurrencyRateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CurrencyRateMultiError(errors)
+	}
+
+	return nil
+}
+
+// CurrencyRateMultiError is an error wrapping multiple validation errors
+// returned by CurrencyRate.ValidateAll() if the designated constraints aren't met.
+type CurrencyRateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CurrencyRateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CurrencyRateMultiError) AllErrors() []error { return m }
+
+// CurrencyRateValidationError is the validation error returned by
+// CurrencyRate.Validate if the designated constraints aren't met.
+type CurrencyRateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CurrencyRateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CurrencyRateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CurrencyRateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CurrencyRateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CurrencyRateValidationError) ErrorName() string { return "CurrencyRateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CurrencyRateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCurrencyRate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CurrencyRateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CurrencyRateValidationError{}
+
+// Validate checks the field values on ListCurrencyRatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCurrencyRatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCurrencyRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCurrencyRatesResponseMultiError, or nil if none found.
+func (m *ListCurrencyRatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCurrencyRatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCurrencyRatesResponseValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCurrencyRatesResponseValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCurrencyRatesResponseValidationError{
+					field:  fmt.Sprintf("Rates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCurrencyRatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCurrencyRatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCurrencyRatesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListCurrencyRatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCurrencyRatesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCurrencyRatesResponseMultiError) AllErrors() []error { return m }
+
+// ListCurrencyRatesResponseValidationError is the validation error returned by
+// ListCurrencyRatesResponse.Validate if the designated constraints aren't met.
+type ListCurrencyRatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCurrencyRatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCurrencyRatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCurrencyRatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCurrencyRatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCurrencyRatesResponseValidationError) ErrorName() string {
+	return "ListCurrencyRatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCurrencyRatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCurrencyRatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCurrencyRatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCurrencyRatesResponseValidationError{}
+
+// Validate checks the field values on ListTrendingEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingEventsRequestMultiError, or nil if none found.
+func (m *ListTrendingEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCity()) < 1 {
+		err := ListTrendingEventsRequestValidationError{
+			field:  "City",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListTrendingEventsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListTrendingEventsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListTrendingEventsRequestValidationError{
+				field:  "Limit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTrendingEventsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListTrendingEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListTrendingEventsRequestValidationError is the validation error returned by
+// ListTrendingEventsRequest.Validate if the designated constraints aren't met.
+type ListTrendingEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingEventsRequestValidationError) ErrorName() string {
+	return "ListTrendingEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingEventsRequestValidationError{}
+
+// Validate checks the field values on TrendingEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrendingEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendingEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrendingEventMultiError, or
+// nil if none found.
+func (m *TrendingEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendingEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrendingEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrendingEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrendingEventValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	// no validation rules for Views
+
+	// no validation rules for Favorites
+
+	// no validation rules for Rsvps
+
+	// no validation rules for Reviews
+
+	if len(errors) > 0 {
+		return TrendingEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendingEventMultiError is an error wrapping multiple validation errors
+// returned by TrendingEvent.ValidateAll() if the designated constraints
+// aren't met.
+type TrendingEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendingEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendingEventMultiError) AllErrors() []error { return m }
+
+// TrendingEventValidationError is the validation error returned by
+// TrendingEvent.Validate if the designated constraints aren't met.
+type TrendingEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendingEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendingEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendingEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendingEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendingEventValidationError) ErrorName() string { return "TrendingEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrendingEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendingEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendingEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendingEventValidationError{}
+
+// Validate checks the field values on ListTrendingEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingEventsResponseMultiError, or nil if none found.
+func (m *ListTrendingEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrendingEventsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrendingEventsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrendingEventsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingEventsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListTrendingEventsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListTrendingEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListTrendingEventsResponseValidationError is the validation error returned
+// by ListTrendingEventsResponse.Validate if the designated constraints aren't met.
+type ListTrendingEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingEventsResponseValidationError) ErrorName() string {
+	return "ListTrendingEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingEventsResponseValidationError{}
+
+// Validate checks the field values on ExportEventIcsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportEventIcsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportEventIcsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportEventIcsRequestMultiError, or nil if none found.
+func (m *ExportEventIcsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportEventIcsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ExportEventIcsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportEventIcsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportEventIcsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportEventIcsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportEventIcsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportEventIcsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportEventIcsRequestMultiError) AllErrors() []error { return m }
+
+// ExportEventIcsRequestValidationError is the validation error returned by
+// ExportEventIcsRequest.Validate if the designated constraints aren't met.
+type ExportEventIcsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportEventIcsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportEventIcsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportEventIcsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportEventIcsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportEventIcsRequestValidationError) ErrorName() string {
+	return "ExportEventIcsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportEventIcsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportEventIcsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportEventIcsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportEventIcsRequestValidationError{}
+
+// Validate checks the field values on CalendarFeed with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CalendarFeed) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalendarFeed with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CalendarFeedMultiError, or
+// nil if none found.
+func (m *CalendarFeed) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalendarFeed) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HttpUrl
+
+	// no validation rules for WebcalUrl
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CalendarFeedValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CalendarFeedValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CalendarFeedValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CalendarFeedMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalendarFeedMultiError is an error wrapping multiple validation errors
+// returned by CalendarFeed.ValidateAll() if the designated constraints aren't met.
+type CalendarFeedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalendarFeedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalendarFeedMultiError) AllErrors() []error { return m }
+
+// CalendarFeedValidationError is the validation error returned by
+// CalendarFeed.Validate if the designated constraints aren't met.
+type CalendarFeedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalendarFeedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalendarFeedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalendarFeedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalendarFeedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalendarFeedValidationError) ErrorName() string { return "CalendarFeedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CalendarFeedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalendarFeed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalendarFeedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalendarFeedValidationError{}
+
+// Validate checks the field values on GetCalendarFeedIcsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCalendarFeedIcsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCalendarFeedIcsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCalendarFeedIcsRequestMultiError, or nil if none found.
+func (m *GetCalendarFeedIcsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCalendarFeedIcsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 64 {
+		err := GetCalendarFeedIcsRequestValidationError{
+			field:  "Token",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCalendarFeedIcsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCalendarFeedIcsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCalendarFeedIcsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetCalendarFeedIcsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCalendarFeedIcsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCalendarFeedIcsRequestMultiError) AllErrors() []error { return m }
+
+// GetCalendarFeedIcsRequestValidationError is the validation error returned by
+// GetCalendarFeedIcsRequest.Validate if the designated constraints aren't met.
+type GetCalendarFeedIcsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCalendarFeedIcsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCalendarFeedIcsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCalendarFeedIcsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCalendarFeedIcsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCalendarFeedIcsRequestValidationError) ErrorName() string {
+	return "GetCalendarFeedIcsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCalendarFeedIcsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCalendarFeedIcsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCalendarFeedIcsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCalendarFeedIcsRequestValidationError{}
+
+// Validate checks the field values on ReminderSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReminderSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReminderSettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReminderSettingsMultiError, or nil if none found.
+func (m *ReminderSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReminderSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetHoursBefore(); val < 1 || val > 168 {
+		err := ReminderSettingsValidationError{
+			field:  "HoursBefore",
+			reason: "value must be inside range [1, 168]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return ReminderSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReminderSettingsMultiError is an error wrapping multiple validation errors
+// returned by ReminderSettings.ValidateAll() if the designated constraints
+// aren't met.
+type ReminderSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReminderSettingsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReminderSettingsMultiError) AllErrors() []error { return m }
+
+// ReminderSettingsValidationError is the validation error returned by
+// ReminderSettings.Validate if the designated constraints aren't met.
+type ReminderSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReminderSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReminderSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReminderSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReminderSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReminderSettingsValidationError) ErrorName() string {
+	return "ReminderSettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReminderSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReminderSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReminderSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReminderSettingsValidationError{}
+
+// Validate checks the field values on GetEventAnalyticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEventAnalyticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEventAnalyticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEventAnalyticsRequestMultiError, or nil if none found.
+func (m *GetEventAnalyticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEventAnalyticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEventId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetEventAnalyticsRequestValidationError{
+					field:  "EventId",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Limit",
+				errors = append(errors, GetEventAnalyticsRequestValidationError{
+					field:  "EventId",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEventId()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "Limit",
+			return GetEventAnalyticsRequestValidationError{
+				field:  "EventId",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1290,28 +8069,28 @@ func (m *ListEventsRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetLastId()).(type) {
+		switch v := interface{}(m.GetCity()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "LastId",
+				errors = append(errors, GetEventAnalyticsRequestValidationError{
+					field:  "City",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "LastId",
+				errors = append(errors, GetEventAnalyticsRequestValidationError{
+					field:  "City",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetLastId()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "LastId",
+			return GetEventAnalyticsRequestValidationError{
+				field:  "City",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1319,52 +8098,70 @@ func (m *ListEventsRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetOffset()).(type) {
+		switch v := interface{}(m.GetCategory()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Offset",
+				errors = append(errors, GetEventAnalyticsRequestValidationError{
+					field:  "Category",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
-					field:  "Offset",
+				errors = append(errors, GetEventAnalyticsRequestValidationError{
+					field:  "Category",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOffset()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
-				field:  "Offset",
+			return GetEventAnalyticsRequestValidationError{
+				field:  "Category",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.EventType != nil {
-		// no validation rules for EventType
+	if utf8.RuneCountInString(m.GetDateFrom()) != 10 {
+		err := GetEventAnalyticsRequestValidationError{
+			field:  "DateFrom",
+			reason: "value length must be 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDateTo()) != 10 {
+		err := GetEventAnalyticsRequestValidationError{
+			field:  "DateTo",
+			reason: "value length must be 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ListEventsRequestMultiError(errors)
+		return GetEventAnalyticsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEventsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListEventsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListEventsRequestMultiError []error
+// GetEventAnalyticsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetEventAnalyticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetEventAnalyticsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEventsRequestMultiError) Error() string {
+func (m GetEventAnalyticsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1373,11 +8170,11 @@ func (m ListEventsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+func (m GetEventAnalyticsRequestMultiError) AllErrors() []error { return m }
 
-// ListEventsRequestValidationError is the validation error returned by
-// ListEventsRequest.Validate if the designated constraints aren't met.
-type ListEventsRequestValidationError struct {
+// GetEventAnalyticsRequestValidationError is the validation error returned by
+// GetEventAnalyticsRequest.Validate if the designated constraints aren't met.
+type GetEventAnalyticsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1385,24 +8182,24 @@ type ListEventsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEventsRequestValidationError) Field() string { return e.field }
+func (e GetEventAnalyticsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+func (e GetEventAnalyticsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+func (e GetEventAnalyticsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEventsRequestValidationError) Key() bool { return e.key }
+func (e GetEventAnalyticsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEventsRequestValidationError) ErrorName() string {
-	return "ListEventsRequestValidationError"
+func (e GetEventAnalyticsRequestValidationError) ErrorName() string {
+	return "GetEventAnalyticsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEventsRequestValidationError) Error() string {
+func (e GetEventAnalyticsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1414,14 +8211,14 @@ func (e ListEventsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEventsRequest.%s: %s%s",
+		"invalid %sGetEventAnalyticsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEventsRequestValidationError{}
+var _ error = GetEventAnalyticsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1429,48 +8226,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEventsRequestValidationError{}
+} = GetEventAnalyticsRequestValidationError{}
 
-// Validate checks the field values on EventCategory with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ViewStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *EventCategory) Validate() error {
+func (m *ViewStats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EventCategory with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in EventCategoryMultiError, or
-// nil if none found.
-func (m *EventCategory) ValidateAll() error {
+// ValidateAll checks the field values on ViewStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ViewStatsMultiError, or nil
+// if none found.
+func (m *ViewStats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EventCategory) validate(all bool) error {
+func (m *ViewStats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Title
+	// no validation rules for Date
 
-	// no validation rules for Code
+	// no validation rules for Views
+
+	// no validation rules for UniqueViewers
+
+	// no validation rules for Favorites
+
+	// no validation rules for Impressions
+
+	// no validation rules for ListViews
+
+	// no validation rules for Ctr
 
 	if len(errors) > 0 {
-		return EventCategoryMultiError(errors)
+		return ViewStatsMultiError(errors)
 	}
 
 	return nil
 }
 
-// EventCategoryMultiError is an error wrapping multiple validation errors
-// returned by EventCategory.ValidateAll() if the designated constraints
-// aren't met.
-type EventCategoryMultiError []error
+// ViewStatsMultiError is an error wrapping multiple validation errors returned
+// by ViewStats.ValidateAll() if the designated constraints aren't met.
+type ViewStatsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EventCategoryMultiError) Error() string {
+func (m ViewStatsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1479,11 +8285,11 @@ func (m EventCategoryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EventCategoryMultiError) AllErrors() []error { return m }
+func (m ViewStatsMultiError) AllErrors() []error { return m }
 
-// EventCategoryValidationError is the validation error returned by
-// EventCategory.Validate if the designated constraints aren't met.
-type EventCategoryValidationError struct {
+// ViewStatsValidationError is the validation error returned by
+// ViewStats.Validate if the designated constraints aren't met.
+type ViewStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1491,22 +8297,22 @@ type EventCategoryValidationError struct {
 }
 
 // Field function returns field value.
-func (e EventCategoryValidationError) Field() string { return e.field }
+func (e ViewStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EventCategoryValidationError) Reason() string { return e.reason }
+func (e ViewStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EventCategoryValidationError) Cause() error { return e.cause }
+func (e ViewStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EventCategoryValidationError) Key() bool { return e.key }
+func (e ViewStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EventCategoryValidationError) ErrorName() string { return "EventCategoryValidationError" }
+func (e ViewStatsValidationError) ErrorName() string { return "ViewStatsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EventCategoryValidationError) Error() string {
+func (e ViewStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1518,14 +8324,14 @@ func (e EventCategoryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEventCategory.%s: %s%s",
+		"invalid %sViewStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EventCategoryValidationError{}
+var _ error = ViewStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1533,24 +8339,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EventCategoryValidationError{}
+} = ViewStatsValidationError{}
 
-// Validate checks the field values on FiltersValues with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *FiltersValues) Validate() error {
+// Validate checks the field values on GetEventAnalyticsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEventAnalyticsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FiltersValues with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FiltersValuesMultiError, or
-// nil if none found.
-func (m *FiltersValues) ValidateAll() error {
+// ValidateAll checks the field values on GetEventAnalyticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEventAnalyticsResponseMultiError, or nil if none found.
+func (m *GetEventAnalyticsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FiltersValues) validate(all bool) error {
+func (m *GetEventAnalyticsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1558,80 +8364,51 @@ func (m *FiltersValues) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetMinPrice()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, FiltersValuesValidationError{
-					field:  "MinPrice",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, FiltersValuesValidationError{
-					field:  "MinPrice",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMinPrice()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return FiltersValuesValidationError{
-				field:  "MinPrice",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetMaxPrice()).(type) {
+		switch v := interface{}(m.GetTotal()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, FiltersValuesValidationError{
-					field:  "MaxPrice",
+				errors = append(errors, GetEventAnalyticsResponseValidationError{
+					field:  "Total",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, FiltersValuesValidationError{
-					field:  "MaxPrice",
+				errors = append(errors, GetEventAnalyticsResponseValidationError{
+					field:  "Total",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMaxPrice()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return FiltersValuesValidationError{
-				field:  "MaxPrice",
+			return GetEventAnalyticsResponseValidationError{
+				field:  "Total",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetCategories() {
+	for idx, item := range m.GetDays() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, FiltersValuesValidationError{
-						field:  fmt.Sprintf("Categories[%v]", idx),
+					errors = append(errors, GetEventAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, FiltersValuesValidationError{
-						field:  fmt.Sprintf("Categories[%v]", idx),
+					errors = append(errors, GetEventAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1639,8 +8416,8 @@ func (m *FiltersValues) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return FiltersValuesValidationError{
-					field:  fmt.Sprintf("Categories[%v]", idx),
+				return GetEventAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1650,19 +8427,19 @@ func (m *FiltersValues) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return FiltersValuesMultiError(errors)
+		return GetEventAnalyticsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// FiltersValuesMultiError is an error wrapping multiple validation errors
-// returned by FiltersValues.ValidateAll() if the designated constraints
-// aren't met.
-type FiltersValuesMultiError []error
+// GetEventAnalyticsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetEventAnalyticsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetEventAnalyticsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FiltersValuesMultiError) Error() string {
+func (m GetEventAnalyticsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1671,11 +8448,11 @@ func (m FiltersValuesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FiltersValuesMultiError) AllErrors() []error { return m }
+func (m GetEventAnalyticsResponseMultiError) AllErrors() []error { return m }
 
-// FiltersValuesValidationError is the validation error returned by
-// FiltersValues.Validate if the designated constraints aren't met.
-type FiltersValuesValidationError struct {
+// GetEventAnalyticsResponseValidationError is the validation error returned by
+// GetEventAnalyticsResponse.Validate if the designated constraints aren't met.
+type GetEventAnalyticsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1683,22 +8460,24 @@ type FiltersValuesValidationError struct {
 }
 
 // Field function returns field value.
-func (e FiltersValuesValidationError) Field() string { return e.field }
+func (e GetEventAnalyticsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FiltersValuesValidationError) Reason() string { return e.reason }
+func (e GetEventAnalyticsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FiltersValuesValidationError) Cause() error { return e.cause }
+func (e GetEventAnalyticsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FiltersValuesValidationError) Key() bool { return e.key }
+func (e GetEventAnalyticsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FiltersValuesValidationError) ErrorName() string { return "FiltersValuesValidationError" }
+func (e GetEventAnalyticsResponseValidationError) ErrorName() string {
+	return "GetEventAnalyticsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e FiltersValuesValidationError) Error() string {
+func (e GetEventAnalyticsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1710,14 +8489,14 @@ func (e FiltersValuesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFiltersValues.%s: %s%s",
+		"invalid %sGetEventAnalyticsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FiltersValuesValidationError{}
+var _ error = GetEventAnalyticsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1725,107 +8504,159 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FiltersValuesValidationError{}
+} = GetEventAnalyticsResponseValidationError{}
 
-// Validate checks the field values on ListEventsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ImportCurrencyRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEventsResponse) Validate() error {
+func (m *ImportCurrencyRatesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEventsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportCurrencyRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEventsResponseMultiError, or nil if none found.
-func (m *ListEventsResponse) ValidateAll() error {
+// ImportCurrencyRatesRequestMultiError, or nil if none found.
+func (m *ImportCurrencyRatesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEventsResponse) validate(all bool) error {
+func (m *ImportCurrencyRatesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListEventsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListEventsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListEventsResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if l := utf8.RuneCountInString(m.GetFile()); l < 1 || l > 1048576 {
+		err := ImportCurrencyRatesRequestValidationError{
+			field:  "File",
+			reason: "value length must be between 1 and 1048576 runes, inclusive",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if len(errors) > 0 {
+		return ImportCurrencyRatesRequestMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetFilters()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsResponseValidationError{
-					field:  "Filters",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsResponseValidationError{
-					field:  "Filters",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilters()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListEventsResponseValidationError{
-				field:  "Filters",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// ImportCurrencyRatesRequestMultiError is an error wrapping multiple
+// validation errors returned by ImportCurrencyRatesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ImportCurrencyRatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCurrencyRatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCurrencyRatesRequestMultiError) AllErrors() []error { return m }
+
+// ImportCurrencyRatesRequestValidationError is the validation error returned
+// by ImportCurrencyRatesRequest.Validate if the designated constraints aren't met.
+type ImportCurrencyRatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCurrencyRatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCurrencyRatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCurrencyRatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCurrencyRatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCurrencyRatesRequestValidationError) ErrorName() string {
+	return "ImportCurrencyRatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCurrencyRatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCurrencyRatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCurrencyRatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCurrencyRatesRequestValidationError{}
+
+// Validate checks the field values on ImportCurrencyRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCurrencyRatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportCurrencyRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportCurrencyRatesResponseMultiError, or nil if none found.
+func (m *ImportCurrencyRatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportCurrencyRatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Imported
+
 	if len(errors) > 0 {
-		return ListEventsResponseMultiError(errors)
+		return ImportCurrencyRatesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEventsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListEventsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListEventsResponseMultiError []error
+// ImportCurrencyRatesResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportCurrencyRatesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ImportCurrencyRatesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEventsResponseMultiError) Error() string {
+func (m ImportCurrencyRatesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1834,11 +8665,12 @@ func (m ListEventsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEventsResponseMultiError) AllErrors() []error { return m }
+func (m ImportCurrencyRatesResponseMultiError) AllErrors() []error { return m }
 
-// ListEventsResponseValidationError is the validation error returned by
-// ListEventsResponse.Validate if the designated constraints aren't met.
-type ListEventsResponseValidationError struct {
+// ImportCurrencyRatesResponseValidationError is the validation error returned
+// by ImportCurrencyRatesResponse.Validate if the designated constraints
+// aren't met.
+type ImportCurrencyRatesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1846,24 +8678,24 @@ type ListEventsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEventsResponseValidationError) Field() string { return e.field }
+func (e ImportCurrencyRatesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+func (e ImportCurrencyRatesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+func (e ImportCurrencyRatesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEventsResponseValidationError) Key() bool { return e.key }
+func (e ImportCurrencyRatesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEventsResponseValidationError) ErrorName() string {
-	return "ListEventsResponseValidationError"
+func (e ImportCurrencyRatesResponseValidationError) ErrorName() string {
+	return "ImportCurrencyRatesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEventsResponseValidationError) Error() string {
+func (e ImportCurrencyRatesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1875,14 +8707,14 @@ func (e ListEventsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEventsResponse.%s: %s%s",
+		"invalid %sImportCurrencyRatesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEventsResponseValidationError{}
+var _ error = ImportCurrencyRatesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1890,4 +8722,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEventsResponseValidationError{}
+} = ImportCurrencyRatesResponseValidationError{}
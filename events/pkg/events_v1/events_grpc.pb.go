@@ -8,9 +8,11 @@ package events_v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,8 +21,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Event_V1_GetEvent_FullMethodName   = "/events_v1.Event_V1/GetEvent"
-	Event_V1_ListEvents_FullMethodName = "/events_v1.Event_V1/ListEvents"
+	Event_V1_GetEvent_FullMethodName               = "/events_v1.Event_V1/GetEvent"
+	Event_V1_ListEvents_FullMethodName             = "/events_v1.Event_V1/ListEvents"
+	Event_V1_GetEventsMap_FullMethodName           = "/events_v1.Event_V1/GetEventsMap"
+	Event_V1_SuggestEvents_FullMethodName          = "/events_v1.Event_V1/SuggestEvents"
+	Event_V1_AddFavorite_FullMethodName            = "/events_v1.Event_V1/AddFavorite"
+	Event_V1_RemoveFavorite_FullMethodName         = "/events_v1.Event_V1/RemoveFavorite"
+	Event_V1_ListFavorites_FullMethodName          = "/events_v1.Event_V1/ListFavorites"
+	Event_V1_SetRsvp_FullMethodName                = "/events_v1.Event_V1/SetRsvp"
+	Event_V1_ListRsvps_FullMethodName              = "/events_v1.Event_V1/ListRsvps"
+	Event_V1_CreateEvent_FullMethodName            = "/events_v1.Event_V1/CreateEvent"
+	Event_V1_UpdateEvent_FullMethodName            = "/events_v1.Event_V1/UpdateEvent"
+	Event_V1_DeleteEvent_FullMethodName            = "/events_v1.Event_V1/DeleteEvent"
+	Event_V1_SetEventCategories_FullMethodName     = "/events_v1.Event_V1/SetEventCategories"
+	Event_V1_ListCategoryAliases_FullMethodName    = "/events_v1.Event_V1/ListCategoryAliases"
+	Event_V1_SetCategoryAlias_FullMethodName       = "/events_v1.Event_V1/SetCategoryAlias"
+	Event_V1_DeleteCategoryAlias_FullMethodName    = "/events_v1.Event_V1/DeleteCategoryAlias"
+	Event_V1_ReplayDeadLetters_FullMethodName      = "/events_v1.Event_V1/ReplayDeadLetters"
+	Event_V1_ListCurrencyRates_FullMethodName      = "/events_v1.Event_V1/ListCurrencyRates"
+	Event_V1_ImportCurrencyRates_FullMethodName    = "/events_v1.Event_V1/ImportCurrencyRates"
+	Event_V1_ListTrendingEvents_FullMethodName     = "/events_v1.Event_V1/ListTrendingEvents"
+	Event_V1_GetEventAnalytics_FullMethodName      = "/events_v1.Event_V1/GetEventAnalytics"
+	Event_V1_ExportEventIcs_FullMethodName         = "/events_v1.Event_V1/ExportEventIcs"
+	Event_V1_GetCalendarFeed_FullMethodName        = "/events_v1.Event_V1/GetCalendarFeed"
+	Event_V1_ResetCalendarFeed_FullMethodName      = "/events_v1.Event_V1/ResetCalendarFeed"
+	Event_V1_GetCalendarFeedIcs_FullMethodName     = "/events_v1.Event_V1/GetCalendarFeedIcs"
+	Event_V1_GetReminderSettings_FullMethodName    = "/events_v1.Event_V1/GetReminderSettings"
+	Event_V1_UpdateReminderSettings_FullMethodName = "/events_v1.Event_V1/UpdateReminderSettings"
 )
 
 // Event_V1Client is the client API for Event_V1 service.
@@ -29,6 +56,34 @@ const (
 type Event_V1Client interface {
 	GetEvent(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	GetEventsMap(ctx context.Context, in *EventsMapRequest, opts ...grpc.CallOption) (*EventsMapResponse, error)
+	SuggestEvents(ctx context.Context, in *SuggestEventsRequest, opts ...grpc.CallOption) (*SuggestEventsResponse, error)
+	AddFavorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveFavorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error)
+	SetRsvp(ctx context.Context, in *SetRsvpRequest, opts ...grpc.CallOption) (*SetRsvpResponse, error)
+	ListRsvps(ctx context.Context, in *ListRsvpsRequest, opts ...grpc.CallOption) (*ListRsvpsResponse, error)
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error)
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetEventCategories(ctx context.Context, in *SetEventCategoriesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListCategoryAliases(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCategoryAliasesResponse, error)
+	SetCategoryAlias(ctx context.Context, in *SetCategoryAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCategoryAlias(ctx context.Context, in *DeleteCategoryAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
+	ListCurrencyRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCurrencyRatesResponse, error)
+	ImportCurrencyRates(ctx context.Context, in *ImportCurrencyRatesRequest, opts ...grpc.CallOption) (*ImportCurrencyRatesResponse, error)
+	ListTrendingEvents(ctx context.Context, in *ListTrendingEventsRequest, opts ...grpc.CallOption) (*ListTrendingEventsResponse, error)
+	GetEventAnalytics(ctx context.Context, in *GetEventAnalyticsRequest, opts ...grpc.CallOption) (*GetEventAnalyticsResponse, error)
+	// по HTTP также доступен как /events/v1/{id}.ics
+	ExportEventIcs(ctx context.Context, in *ExportEventIcsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	GetCalendarFeed(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CalendarFeed, error)
+	ResetCalendarFeed(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CalendarFeed, error)
+	// лента подписки, доступ по токену без авторизации; по HTTP также /events/v1/calendar/{token}.ics
+	GetCalendarFeedIcs(ctx context.Context, in *GetCalendarFeedIcsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// напоминания о сеансах событий из избранного и с rsvp going/interested
+	GetReminderSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReminderSettings, error)
+	UpdateReminderSettings(ctx context.Context, in *ReminderSettings, opts ...grpc.CallOption) (*ReminderSettings, error)
 }
 
 type event_V1Client struct {
@@ -59,12 +114,290 @@ func (c *event_V1Client) ListEvents(ctx context.Context, in *ListEventsRequest,
 	return out, nil
 }
 
+func (c *event_V1Client) GetEventsMap(ctx context.Context, in *EventsMapRequest, opts ...grpc.CallOption) (*EventsMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventsMapResponse)
+	err := c.cc.Invoke(ctx, Event_V1_GetEventsMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) SuggestEvents(ctx context.Context, in *SuggestEventsRequest, opts ...grpc.CallOption) (*SuggestEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestEventsResponse)
+	err := c.cc.Invoke(ctx, Event_V1_SuggestEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) AddFavorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Event_V1_AddFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) RemoveFavorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Event_V1_RemoveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavoritesResponse)
+	err := c.cc.Invoke(ctx, Event_V1_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) SetRsvp(ctx context.Context, in *SetRsvpRequest, opts ...grpc.CallOption) (*SetRsvpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRsvpResponse)
+	err := c.cc.Invoke(ctx, Event_V1_SetRsvp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ListRsvps(ctx context.Context, in *ListRsvpsRequest, opts ...grpc.CallOption) (*ListRsvpsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRsvpsResponse)
+	err := c.cc.Invoke(ctx, Event_V1_ListRsvps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEventResponse)
+	err := c.cc.Invoke(ctx, Event_V1_CreateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Event_V1_UpdateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Event_V1_DeleteEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) SetEventCategories(ctx context.Context, in *SetEventCategoriesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Event_V1_SetEventCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ListCategoryAliases(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCategoryAliasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryAliasesResponse)
+	err := c.cc.Invoke(ctx, Event_V1_ListCategoryAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) SetCategoryAlias(ctx context.Context, in *SetCategoryAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Event_V1_SetCategoryAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) DeleteCategoryAlias(ctx context.Context, in *DeleteCategoryAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Event_V1_DeleteCategoryAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Event_V1_ReplayDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ListCurrencyRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCurrencyRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCurrencyRatesResponse)
+	err := c.cc.Invoke(ctx, Event_V1_ListCurrencyRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ImportCurrencyRates(ctx context.Context, in *ImportCurrencyRatesRequest, opts ...grpc.CallOption) (*ImportCurrencyRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCurrencyRatesResponse)
+	err := c.cc.Invoke(ctx, Event_V1_ImportCurrencyRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ListTrendingEvents(ctx context.Context, in *ListTrendingEventsRequest, opts ...grpc.CallOption) (*ListTrendingEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrendingEventsResponse)
+	err := c.cc.Invoke(ctx, Event_V1_ListTrendingEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) GetEventAnalytics(ctx context.Context, in *GetEventAnalyticsRequest, opts ...grpc.CallOption) (*GetEventAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventAnalyticsResponse)
+	err := c.cc.Invoke(ctx, Event_V1_GetEventAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ExportEventIcs(ctx context.Context, in *ExportEventIcsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, Event_V1_ExportEventIcs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) GetCalendarFeed(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, Event_V1_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) ResetCalendarFeed(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, Event_V1_ResetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) GetCalendarFeedIcs(ctx context.Context, in *GetCalendarFeedIcsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, Event_V1_GetCalendarFeedIcs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) GetReminderSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReminderSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReminderSettings)
+	err := c.cc.Invoke(ctx, Event_V1_GetReminderSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *event_V1Client) UpdateReminderSettings(ctx context.Context, in *ReminderSettings, opts ...grpc.CallOption) (*ReminderSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReminderSettings)
+	err := c.cc.Invoke(ctx, Event_V1_UpdateReminderSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Event_V1Server is the server API for Event_V1 service.
 // All implementations must embed UnimplementedEvent_V1Server
 // for forward compatibility.
 type Event_V1Server interface {
 	GetEvent(context.Context, *GetRequest) (*GetResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	GetEventsMap(context.Context, *EventsMapRequest) (*EventsMapResponse, error)
+	SuggestEvents(context.Context, *SuggestEventsRequest) (*SuggestEventsResponse, error)
+	AddFavorite(context.Context, *FavoriteRequest) (*emptypb.Empty, error)
+	RemoveFavorite(context.Context, *FavoriteRequest) (*emptypb.Empty, error)
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error)
+	SetRsvp(context.Context, *SetRsvpRequest) (*SetRsvpResponse, error)
+	ListRsvps(context.Context, *ListRsvpsRequest) (*ListRsvpsResponse, error)
+	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
+	UpdateEvent(context.Context, *UpdateEventRequest) (*emptypb.Empty, error)
+	DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error)
+	SetEventCategories(context.Context, *SetEventCategoriesRequest) (*emptypb.Empty, error)
+	ListCategoryAliases(context.Context, *emptypb.Empty) (*ListCategoryAliasesResponse, error)
+	SetCategoryAlias(context.Context, *SetCategoryAliasRequest) (*emptypb.Empty, error)
+	DeleteCategoryAlias(context.Context, *DeleteCategoryAliasRequest) (*emptypb.Empty, error)
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error)
+	ListCurrencyRates(context.Context, *emptypb.Empty) (*ListCurrencyRatesResponse, error)
+	ImportCurrencyRates(context.Context, *ImportCurrencyRatesRequest) (*ImportCurrencyRatesResponse, error)
+	ListTrendingEvents(context.Context, *ListTrendingEventsRequest) (*ListTrendingEventsResponse, error)
+	GetEventAnalytics(context.Context, *GetEventAnalyticsRequest) (*GetEventAnalyticsResponse, error)
+	// по HTTP также доступен как /events/v1/{id}.ics
+	ExportEventIcs(context.Context, *ExportEventIcsRequest) (*httpbody.HttpBody, error)
+	GetCalendarFeed(context.Context, *emptypb.Empty) (*CalendarFeed, error)
+	ResetCalendarFeed(context.Context, *emptypb.Empty) (*CalendarFeed, error)
+	// лента подписки, доступ по токену без авторизации; по HTTP также /events/v1/calendar/{token}.ics
+	GetCalendarFeedIcs(context.Context, *GetCalendarFeedIcsRequest) (*httpbody.HttpBody, error)
+	// напоминания о сеансах событий из избранного и с rsvp going/interested
+	GetReminderSettings(context.Context, *emptypb.Empty) (*ReminderSettings, error)
+	UpdateReminderSettings(context.Context, *ReminderSettings) (*ReminderSettings, error)
 	mustEmbedUnimplementedEvent_V1Server()
 }
 
@@ -81,6 +414,81 @@ func (UnimplementedEvent_V1Server) GetEvent(context.Context, *GetRequest) (*GetR
 func (UnimplementedEvent_V1Server) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedEvent_V1Server) GetEventsMap(context.Context, *EventsMapRequest) (*EventsMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventsMap not implemented")
+}
+func (UnimplementedEvent_V1Server) SuggestEvents(context.Context, *SuggestEventsRequest) (*SuggestEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestEvents not implemented")
+}
+func (UnimplementedEvent_V1Server) AddFavorite(context.Context, *FavoriteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedEvent_V1Server) RemoveFavorite(context.Context, *FavoriteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedEvent_V1Server) ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedEvent_V1Server) SetRsvp(context.Context, *SetRsvpRequest) (*SetRsvpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRsvp not implemented")
+}
+func (UnimplementedEvent_V1Server) ListRsvps(context.Context, *ListRsvpsRequest) (*ListRsvpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRsvps not implemented")
+}
+func (UnimplementedEvent_V1Server) CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEvent_V1Server) UpdateEvent(context.Context, *UpdateEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (UnimplementedEvent_V1Server) DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (UnimplementedEvent_V1Server) SetEventCategories(context.Context, *SetEventCategoriesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventCategories not implemented")
+}
+func (UnimplementedEvent_V1Server) ListCategoryAliases(context.Context, *emptypb.Empty) (*ListCategoryAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryAliases not implemented")
+}
+func (UnimplementedEvent_V1Server) SetCategoryAlias(context.Context, *SetCategoryAliasRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryAlias not implemented")
+}
+func (UnimplementedEvent_V1Server) DeleteCategoryAlias(context.Context, *DeleteCategoryAliasRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryAlias not implemented")
+}
+func (UnimplementedEvent_V1Server) ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
+func (UnimplementedEvent_V1Server) ListCurrencyRates(context.Context, *emptypb.Empty) (*ListCurrencyRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrencyRates not implemented")
+}
+func (UnimplementedEvent_V1Server) ImportCurrencyRates(context.Context, *ImportCurrencyRatesRequest) (*ImportCurrencyRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCurrencyRates not implemented")
+}
+func (UnimplementedEvent_V1Server) ListTrendingEvents(context.Context, *ListTrendingEventsRequest) (*ListTrendingEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingEvents not implemented")
+}
+func (UnimplementedEvent_V1Server) GetEventAnalytics(context.Context, *GetEventAnalyticsRequest) (*GetEventAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventAnalytics not implemented")
+}
+func (UnimplementedEvent_V1Server) ExportEventIcs(context.Context, *ExportEventIcsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEventIcs not implemented")
+}
+func (UnimplementedEvent_V1Server) GetCalendarFeed(context.Context, *emptypb.Empty) (*CalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedEvent_V1Server) ResetCalendarFeed(context.Context, *emptypb.Empty) (*CalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCalendarFeed not implemented")
+}
+func (UnimplementedEvent_V1Server) GetCalendarFeedIcs(context.Context, *GetCalendarFeedIcsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeedIcs not implemented")
+}
+func (UnimplementedEvent_V1Server) GetReminderSettings(context.Context, *emptypb.Empty) (*ReminderSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReminderSettings not implemented")
+}
+func (UnimplementedEvent_V1Server) UpdateReminderSettings(context.Context, *ReminderSettings) (*ReminderSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReminderSettings not implemented")
+}
 func (UnimplementedEvent_V1Server) mustEmbedUnimplementedEvent_V1Server() {}
 func (UnimplementedEvent_V1Server) testEmbeddedByValue()                  {}
 
@@ -138,20 +546,570 @@ func _Event_V1_ListEvents_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-// Event_V1_ServiceDesc is the grpc.ServiceDesc for Event_V1 service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Event_V1_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "events_v1.Event_V1",
-	HandlerType: (*Event_V1Server)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetEvent",
-			Handler:    _Event_V1_GetEvent_Handler,
-		},
-		{
-			MethodName: "ListEvents",
-			Handler:    _Event_V1_ListEvents_Handler,
+func _Event_V1_GetEventsMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventsMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).GetEventsMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_GetEventsMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).GetEventsMap(ctx, req.(*EventsMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_SuggestEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).SuggestEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_SuggestEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).SuggestEvents(ctx, req.(*SuggestEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_AddFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).AddFavorite(ctx, req.(*FavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_RemoveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).RemoveFavorite(ctx, req.(*FavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_SetRsvp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRsvpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).SetRsvp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_SetRsvp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).SetRsvp(ctx, req.(*SetRsvpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ListRsvps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRsvpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ListRsvps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ListRsvps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ListRsvps(ctx, req.(*ListRsvpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_UpdateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_DeleteEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_SetEventCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).SetEventCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_SetEventCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).SetEventCategories(ctx, req.(*SetEventCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ListCategoryAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ListCategoryAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ListCategoryAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ListCategoryAliases(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_SetCategoryAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).SetCategoryAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_SetCategoryAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).SetCategoryAlias(ctx, req.(*SetCategoryAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_DeleteCategoryAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).DeleteCategoryAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_DeleteCategoryAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).DeleteCategoryAlias(ctx, req.(*DeleteCategoryAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ListCurrencyRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ListCurrencyRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ListCurrencyRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ListCurrencyRates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ImportCurrencyRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCurrencyRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ImportCurrencyRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ImportCurrencyRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ImportCurrencyRates(ctx, req.(*ImportCurrencyRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ListTrendingEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ListTrendingEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ListTrendingEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ListTrendingEvents(ctx, req.(*ListTrendingEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_GetEventAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).GetEventAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_GetEventAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).GetEventAnalytics(ctx, req.(*GetEventAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ExportEventIcs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEventIcsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ExportEventIcs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ExportEventIcs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ExportEventIcs(ctx, req.(*ExportEventIcsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).GetCalendarFeed(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_ResetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).ResetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_ResetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).ResetCalendarFeed(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_GetCalendarFeedIcs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedIcsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).GetCalendarFeedIcs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_GetCalendarFeedIcs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).GetCalendarFeedIcs(ctx, req.(*GetCalendarFeedIcsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_GetReminderSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).GetReminderSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_GetReminderSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).GetReminderSettings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_V1_UpdateReminderSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReminderSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Event_V1Server).UpdateReminderSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_V1_UpdateReminderSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Event_V1Server).UpdateReminderSettings(ctx, req.(*ReminderSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Event_V1_ServiceDesc is the grpc.ServiceDesc for Event_V1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Event_V1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "events_v1.Event_V1",
+	HandlerType: (*Event_V1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEvent",
+			Handler:    _Event_V1_GetEvent_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _Event_V1_ListEvents_Handler,
+		},
+		{
+			MethodName: "GetEventsMap",
+			Handler:    _Event_V1_GetEventsMap_Handler,
+		},
+		{
+			MethodName: "SuggestEvents",
+			Handler:    _Event_V1_SuggestEvents_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _Event_V1_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _Event_V1_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _Event_V1_ListFavorites_Handler,
+		},
+		{
+			MethodName: "SetRsvp",
+			Handler:    _Event_V1_SetRsvp_Handler,
+		},
+		{
+			MethodName: "ListRsvps",
+			Handler:    _Event_V1_ListRsvps_Handler,
+		},
+		{
+			MethodName: "CreateEvent",
+			Handler:    _Event_V1_CreateEvent_Handler,
+		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _Event_V1_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "DeleteEvent",
+			Handler:    _Event_V1_DeleteEvent_Handler,
+		},
+		{
+			MethodName: "SetEventCategories",
+			Handler:    _Event_V1_SetEventCategories_Handler,
+		},
+		{
+			MethodName: "ListCategoryAliases",
+			Handler:    _Event_V1_ListCategoryAliases_Handler,
+		},
+		{
+			MethodName: "SetCategoryAlias",
+			Handler:    _Event_V1_SetCategoryAlias_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryAlias",
+			Handler:    _Event_V1_DeleteCategoryAlias_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _Event_V1_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "ListCurrencyRates",
+			Handler:    _Event_V1_ListCurrencyRates_Handler,
+		},
+		{
+			MethodName: "ImportCurrencyRates",
+			Handler:    _Event_V1_ImportCurrencyRates_Handler,
+		},
+		{
+			MethodName: "ListTrendingEvents",
+			Handler:    _Event_V1_ListTrendingEvents_Handler,
+		},
+		{
+			MethodName: "GetEventAnalytics",
+			Handler:    _Event_V1_GetEventAnalytics_Handler,
+		},
+		{
+			MethodName: "ExportEventIcs",
+			Handler:    _Event_V1_ExportEventIcs_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _Event_V1_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ResetCalendarFeed",
+			Handler:    _Event_V1_ResetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeedIcs",
+			Handler:    _Event_V1_GetCalendarFeedIcs_Handler,
+		},
+		{
+			MethodName: "GetReminderSettings",
+			Handler:    _Event_V1_GetReminderSettings_Handler,
+		},
+		{
+			MethodName: "UpdateReminderSettings",
+			Handler:    _Event_V1_UpdateReminderSettings_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
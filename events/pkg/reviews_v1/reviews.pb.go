@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -74,8 +75,14 @@ func (MediaType) EnumDescriptor() ([]byte, []int) {
 }
 
 type ListReviewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// newest (по умолчанию), highest, lowest, helpful, with_media
+	Sort *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	// только отзывы с фотографиями
+	WithPhotos    bool                    `protobuf:"varint,3,opt,name=with_photos,proto3" json:"with_photos,omitempty"`
+	Limit         *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,11 +124,42 @@ func (x *ListReviewsRequest) GetEventId() int64 {
 	return 0
 }
 
+func (x *ListReviewsRequest) GetSort() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListReviewsRequest) GetWithPhotos() bool {
+	if x != nil {
+		return x.WithPhotos
+	}
+	return false
+}
+
+func (x *ListReviewsRequest) GetLimit() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *ListReviewsRequest) GetCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
 type ListReviewsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
-	Rating        float32                `protobuf:"fixed32,2,opt,name=rating,proto3" json:"rating,omitempty"`
-	ReviewsCount  int32                  `protobuf:"varint,3,opt,name=reviews_count,proto3" json:"reviews_count,omitempty"`
+	state        protoimpl.MessageState  `protogen:"open.v1"`
+	Reviews      []*Review               `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Rating       float32                 `protobuf:"fixed32,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReviewsCount int32                   `protobuf:"varint,3,opt,name=reviews_count,proto3" json:"reviews_count,omitempty"`
+	NextCursor   *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+	// распределение оценок 0-10 по всем отзывам события, без учёта фильтра и пагинации
+	Histogram     []*GradeCount `protobuf:"bytes,5,rep,name=histogram,proto3" json:"histogram,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -177,9 +215,75 @@ func (x *ListReviewsResponse) GetReviewsCount() int32 {
 	return 0
 }
 
+func (x *ListReviewsResponse) GetNextCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetHistogram() []*GradeCount {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+type GradeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grade         int32                  `protobuf:"varint,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeCount) Reset() {
+	*x = GradeCount{}
+	mi := &file_reviews_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeCount) ProtoMessage() {}
+
+func (x *GradeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_reviews_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeCount.ProtoReflect.Descriptor instead.
+func (*GradeCount) Descriptor() ([]byte, []int) {
+	return file_reviews_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GradeCount) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *GradeCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type MediaAttachment struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// string storage_key = 1 [(validate.rules).string.uri = true];
+	//  string storage_key = 1 [(validate.rules).string.uri = true];
 	StorageKey    string    `protobuf:"bytes,1,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"`
 	Type          MediaType `protobuf:"varint,2,opt,name=type,proto3,enum=reviews_v1.MediaType" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -188,7 +292,7 @@ type MediaAttachment struct {
 
 func (x *MediaAttachment) Reset() {
 	*x = MediaAttachment{}
-	mi := &file_reviews_proto_msgTypes[2]
+	mi := &file_reviews_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -200,7 +304,7 @@ func (x *MediaAttachment) String() string {
 func (*MediaAttachment) ProtoMessage() {}
 
 func (x *MediaAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_reviews_proto_msgTypes[2]
+	mi := &file_reviews_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,7 +317,7 @@ func (x *MediaAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MediaAttachment.ProtoReflect.Descriptor instead.
 func (*MediaAttachment) Descriptor() ([]byte, []int) {
-	return file_reviews_proto_rawDescGZIP(), []int{2}
+	return file_reviews_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MediaAttachment) GetStorageKey() string {
@@ -237,15 +341,20 @@ type Review struct {
 	Disadvantages string                 `protobuf:"bytes,3,opt,name=disadvantages,proto3" json:"disadvantages,omitempty"`
 	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
 	Media         []*MediaAttachment     `protobuf:"bytes,5,rep,name=media,proto3" json:"media,omitempty"`
-	AuthorId      int64                  `protobuf:"varint,6,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// заполняется только в ответе: автором отзыва всегда становится авторизованный пользователь
+	AuthorId  int64                  `protobuf:"varint,6,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id        int64                  `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
+	// время последнего изменения, не задано - отзыв не редактировался
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,proto3" json:"edited_at,omitempty"`
+	HelpfulCount  int32                  `protobuf:"varint,10,opt,name=helpful_count,proto3" json:"helpful_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Review) Reset() {
 	*x = Review{}
-	mi := &file_reviews_proto_msgTypes[3]
+	mi := &file_reviews_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -257,7 +366,7 @@ func (x *Review) String() string {
 func (*Review) ProtoMessage() {}
 
 func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_reviews_proto_msgTypes[3]
+	mi := &file_reviews_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,7 +379,7 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Review.ProtoReflect.Descriptor instead.
 func (*Review) Descriptor() ([]byte, []int) {
-	return file_reviews_proto_rawDescGZIP(), []int{3}
+	return file_reviews_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Review) GetGrade() int32 {
@@ -322,6 +431,27 @@ func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Review) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Review) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Review) GetHelpfulCount() int32 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
 type CreateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
@@ -332,7 +462,7 @@ type CreateReviewRequest struct {
 
 func (x *CreateReviewRequest) Reset() {
 	*x = CreateReviewRequest{}
-	mi := &file_reviews_proto_msgTypes[4]
+	mi := &file_reviews_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -344,7 +474,7 @@ func (x *CreateReviewRequest) String() string {
 func (*CreateReviewRequest) ProtoMessage() {}
 
 func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reviews_proto_msgTypes[4]
+	mi := &file_reviews_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +487,7 @@ func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
 func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
-	return file_reviews_proto_rawDescGZIP(), []int{4}
+	return file_reviews_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateReviewRequest) GetEventId() int64 {
@@ -382,7 +512,7 @@ type CreateReviewResponse struct {
 
 func (x *CreateReviewResponse) Reset() {
 	*x = CreateReviewResponse{}
-	mi := &file_reviews_proto_msgTypes[5]
+	mi := &file_reviews_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +524,7 @@ func (x *CreateReviewResponse) String() string {
 func (*CreateReviewResponse) ProtoMessage() {}
 
 func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reviews_proto_msgTypes[5]
+	mi := &file_reviews_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +537,273 @@ func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
 func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
-	return file_reviews_proto_rawDescGZIP(), []int{5}
+	return file_reviews_proto_rawDescGZIP(), []int{6}
+}
+
+type UpdateReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// медиа отзыва заменяются целиком
+	Review        *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_reviews_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewRequest) ProtoMessage() {}
+
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviews_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviews_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type UpdateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewResponse) Reset() {
+	*x = UpdateReviewResponse{}
+	mi := &file_reviews_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewResponse) ProtoMessage() {}
+
+func (x *UpdateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviews_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_reviews_proto_rawDescGZIP(), []int{8}
+}
+
+type DeleteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_reviews_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviews_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviews_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewResponse) Reset() {
+	*x = DeleteReviewResponse{}
+	mi := &file_reviews_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewResponse) ProtoMessage() {}
+
+func (x *DeleteReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviews_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return file_reviews_proto_rawDescGZIP(), []int{10}
+}
+
+type MarkReviewHelpfulRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// false - снять голос
+	Helpful       bool `protobuf:"varint,2,opt,name=helpful,proto3" json:"helpful,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReviewHelpfulRequest) Reset() {
+	*x = MarkReviewHelpfulRequest{}
+	mi := &file_reviews_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReviewHelpfulRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReviewHelpfulRequest) ProtoMessage() {}
+
+func (x *MarkReviewHelpfulRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviews_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReviewHelpfulRequest.ProtoReflect.Descriptor instead.
+func (*MarkReviewHelpfulRequest) Descriptor() ([]byte, []int) {
+	return file_reviews_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarkReviewHelpfulRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarkReviewHelpfulRequest) GetHelpful() bool {
+	if x != nil {
+		return x.Helpful
+	}
+	return false
+}
+
+type MarkReviewHelpfulResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HelpfulCount  int32                  `protobuf:"varint,1,opt,name=helpful_count,proto3" json:"helpful_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReviewHelpfulResponse) Reset() {
+	*x = MarkReviewHelpfulResponse{}
+	mi := &file_reviews_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReviewHelpfulResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReviewHelpfulResponse) ProtoMessage() {}
+
+func (x *MarkReviewHelpfulResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviews_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReviewHelpfulResponse.ProtoReflect.Descriptor instead.
+func (*MarkReviewHelpfulResponse) Descriptor() ([]byte, []int) {
+	return file_reviews_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarkReviewHelpfulResponse) GetHelpfulCount() int32 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
 }
 
 var File_reviews_proto protoreflect.FileDescriptor
@@ -415,17 +811,27 @@ var File_reviews_proto protoreflect.FileDescriptor
 const file_reviews_proto_rawDesc = "" +
 	"\n" +
 	"\rreviews.proto\x12\n" +
-	"reviews_v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"8\n" +
+	"reviews_v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xf5\x01\n" +
 	"\x12ListReviewsRequest\x12\"\n" +
-	"\bevent_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aeventId\"\x81\x01\n" +
+	"\bevent_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aeventId\x120\n" +
+	"\x04sort\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04sort\x12 \n" +
+	"\vwith_photos\x18\x03 \x01(\bR\vwith_photos\x121\n" +
+	"\x05limit\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05limit\x124\n" +
+	"\x06cursor\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x06cursor\"\xf7\x01\n" +
 	"\x13ListReviewsResponse\x12,\n" +
 	"\areviews\x18\x01 \x03(\v2\x12.reviews_v1.ReviewR\areviews\x12\x16\n" +
 	"\x06rating\x18\x02 \x01(\x02R\x06rating\x12$\n" +
-	"\rreviews_count\x18\x03 \x01(\x05R\rreviews_count\"g\n" +
+	"\rreviews_count\x18\x03 \x01(\x05R\rreviews_count\x12>\n" +
+	"\vnext_cursor\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\vnext_cursor\x124\n" +
+	"\thistogram\x18\x05 \x03(\v2\x16.reviews_v1.GradeCountR\thistogram\"8\n" +
+	"\n" +
+	"GradeCount\x12\x14\n" +
+	"\x05grade\x18\x01 \x01(\x05R\x05grade\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"g\n" +
 	"\x0fMediaAttachment\x12\x1f\n" +
 	"\vstorage_key\x18\x01 \x01(\tR\n" +
 	"storageKey\x123\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x15.reviews_v1.MediaTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\"\xb8\x02\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.reviews_v1.MediaTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\"\xa8\x03\n" +
 	"\x06Review\x12\x1f\n" +
 	"\x05grade\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\n" +
 	"(\x00R\x05grade\x12(\n" +
@@ -438,19 +844,38 @@ const file_reviews_proto_rawDesc = "" +
 	"\x05media\x18\x05 \x03(\v2\x1b.reviews_v1.MediaAttachmentB\b\xfaB\x05\x92\x01\x02\x10\x03R\x05media\x12\x1b\n" +
 	"\tauthor_id\x18\x06 \x01(\x03R\bauthorId\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"o\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x0e\n" +
+	"\x02id\x18\b \x01(\x03R\x02id\x128\n" +
+	"\tedited_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tedited_at\x12$\n" +
+	"\rhelpful_count\x18\n" +
+	" \x01(\x05R\rhelpful_count\"o\n" +
 	"\x13CreateReviewRequest\x12\"\n" +
 	"\bevent_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aeventId\x124\n" +
 	"\x06review\x18\x02 \x01(\v2\x12.reviews_v1.ReviewB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06review\"\x16\n" +
-	"\x14CreateReviewResponse*O\n" +
+	"\x14CreateReviewResponse\"d\n" +
+	"\x13UpdateReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x124\n" +
+	"\x06review\x18\x02 \x01(\v2\x12.reviews_v1.ReviewB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06review\"\x16\n" +
+	"\x14UpdateReviewResponse\".\n" +
+	"\x13DeleteReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\x16\n" +
+	"\x14DeleteReviewResponse\"M\n" +
+	"\x18MarkReviewHelpfulRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x18\n" +
+	"\ahelpful\x18\x02 \x01(\bR\ahelpful\"A\n" +
+	"\x19MarkReviewHelpfulResponse\x12$\n" +
+	"\rhelpful_count\x18\x01 \x01(\x05R\rhelpful_count*O\n" +
 	"\tMediaType\x12\x16\n" +
 	"\x12MEDIA_TYPE_UNKNOWN\x10\x00\x12\x14\n" +
 	"\x10MEDIA_TYPE_IMAGE\x10\x01\x12\x14\n" +
-	"\x10MEDIA_TYPE_VIDEO\x10\x022\xdc\x01\n" +
+	"\x10MEDIA_TYPE_VIDEO\x10\x022\xc1\x04\n" +
 	"\n" +
 	"Reviews_v1\x12c\n" +
 	"\vListReviews\x12\x1e.reviews_v1.ListReviewsRequest\x1a\x1f.reviews_v1.ListReviewsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/reviews/v1\x12i\n" +
-	"\fCreateReview\x12\x1f.reviews_v1.CreateReviewRequest\x1a .reviews_v1.CreateReviewResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/reviews/v1BAZ?GolandProjects/RelocatorEvents/events/pkg/reviews_v1;reviews_v1b\x06proto3"
+	"\fCreateReview\x12\x1f.reviews_v1.CreateReviewRequest\x1a .reviews_v1.CreateReviewResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/reviews/v1\x12n\n" +
+	"\fUpdateReview\x12\x1f.reviews_v1.UpdateReviewRequest\x1a .reviews_v1.UpdateReviewResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/reviews/v1/{id}\x12k\n" +
+	"\fDeleteReview\x12\x1f.reviews_v1.DeleteReviewRequest\x1a .reviews_v1.DeleteReviewResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/reviews/v1/{id}\x12\x85\x01\n" +
+	"\x11MarkReviewHelpful\x12$.reviews_v1.MarkReviewHelpfulRequest\x1a%.reviews_v1.MarkReviewHelpfulResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/reviews/v1/{id}/helpfulBAZ?GolandProjects/RelocatorEvents/events/pkg/reviews_v1;reviews_v1b\x06proto3"
 
 var (
 	file_reviews_proto_rawDescOnce sync.Once
@@ -465,32 +890,54 @@ func file_reviews_proto_rawDescGZIP() []byte {
 }
 
 var file_reviews_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_reviews_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_reviews_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_reviews_proto_goTypes = []any{
-	(MediaType)(0),                // 0: reviews_v1.MediaType
-	(*ListReviewsRequest)(nil),    // 1: reviews_v1.ListReviewsRequest
-	(*ListReviewsResponse)(nil),   // 2: reviews_v1.ListReviewsResponse
-	(*MediaAttachment)(nil),       // 3: reviews_v1.MediaAttachment
-	(*Review)(nil),                // 4: reviews_v1.Review
-	(*CreateReviewRequest)(nil),   // 5: reviews_v1.CreateReviewRequest
-	(*CreateReviewResponse)(nil),  // 6: reviews_v1.CreateReviewResponse
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(MediaType)(0),                    // 0: reviews_v1.MediaType
+	(*ListReviewsRequest)(nil),        // 1: reviews_v1.ListReviewsRequest
+	(*ListReviewsResponse)(nil),       // 2: reviews_v1.ListReviewsResponse
+	(*GradeCount)(nil),                // 3: reviews_v1.GradeCount
+	(*MediaAttachment)(nil),           // 4: reviews_v1.MediaAttachment
+	(*Review)(nil),                    // 5: reviews_v1.Review
+	(*CreateReviewRequest)(nil),       // 6: reviews_v1.CreateReviewRequest
+	(*CreateReviewResponse)(nil),      // 7: reviews_v1.CreateReviewResponse
+	(*UpdateReviewRequest)(nil),       // 8: reviews_v1.UpdateReviewRequest
+	(*UpdateReviewResponse)(nil),      // 9: reviews_v1.UpdateReviewResponse
+	(*DeleteReviewRequest)(nil),       // 10: reviews_v1.DeleteReviewRequest
+	(*DeleteReviewResponse)(nil),      // 11: reviews_v1.DeleteReviewResponse
+	(*MarkReviewHelpfulRequest)(nil),  // 12: reviews_v1.MarkReviewHelpfulRequest
+	(*MarkReviewHelpfulResponse)(nil), // 13: reviews_v1.MarkReviewHelpfulResponse
+	(*wrapperspb.StringValue)(nil),    // 14: google.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),     // 15: google.protobuf.Int64Value
+	(*timestamppb.Timestamp)(nil),     // 16: google.protobuf.Timestamp
 }
 var file_reviews_proto_depIdxs = []int32{
-	4, // 0: reviews_v1.ListReviewsResponse.reviews:type_name -> reviews_v1.Review
-	0, // 1: reviews_v1.MediaAttachment.type:type_name -> reviews_v1.MediaType
-	3, // 2: reviews_v1.Review.media:type_name -> reviews_v1.MediaAttachment
-	7, // 3: reviews_v1.Review.created_at:type_name -> google.protobuf.Timestamp
-	4, // 4: reviews_v1.CreateReviewRequest.review:type_name -> reviews_v1.Review
-	1, // 5: reviews_v1.Reviews_v1.ListReviews:input_type -> reviews_v1.ListReviewsRequest
-	5, // 6: reviews_v1.Reviews_v1.CreateReview:input_type -> reviews_v1.CreateReviewRequest
-	2, // 7: reviews_v1.Reviews_v1.ListReviews:output_type -> reviews_v1.ListReviewsResponse
-	6, // 8: reviews_v1.Reviews_v1.CreateReview:output_type -> reviews_v1.CreateReviewResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	14, // 0: reviews_v1.ListReviewsRequest.sort:type_name -> google.protobuf.StringValue
+	15, // 1: reviews_v1.ListReviewsRequest.limit:type_name -> google.protobuf.Int64Value
+	14, // 2: reviews_v1.ListReviewsRequest.cursor:type_name -> google.protobuf.StringValue
+	5,  // 3: reviews_v1.ListReviewsResponse.reviews:type_name -> reviews_v1.Review
+	14, // 4: reviews_v1.ListReviewsResponse.next_cursor:type_name -> google.protobuf.StringValue
+	3,  // 5: reviews_v1.ListReviewsResponse.histogram:type_name -> reviews_v1.GradeCount
+	0,  // 6: reviews_v1.MediaAttachment.type:type_name -> reviews_v1.MediaType
+	4,  // 7: reviews_v1.Review.media:type_name -> reviews_v1.MediaAttachment
+	16, // 8: reviews_v1.Review.created_at:type_name -> google.protobuf.Timestamp
+	16, // 9: reviews_v1.Review.edited_at:type_name -> google.protobuf.Timestamp
+	5,  // 10: reviews_v1.CreateReviewRequest.review:type_name -> reviews_v1.Review
+	5,  // 11: reviews_v1.UpdateReviewRequest.review:type_name -> reviews_v1.Review
+	1,  // 12: reviews_v1.Reviews_v1.ListReviews:input_type -> reviews_v1.ListReviewsRequest
+	6,  // 13: reviews_v1.Reviews_v1.CreateReview:input_type -> reviews_v1.CreateReviewRequest
+	8,  // 14: reviews_v1.Reviews_v1.UpdateReview:input_type -> reviews_v1.UpdateReviewRequest
+	10, // 15: reviews_v1.Reviews_v1.DeleteReview:input_type -> reviews_v1.DeleteReviewRequest
+	12, // 16: reviews_v1.Reviews_v1.MarkReviewHelpful:input_type -> reviews_v1.MarkReviewHelpfulRequest
+	2,  // 17: reviews_v1.Reviews_v1.ListReviews:output_type -> reviews_v1.ListReviewsResponse
+	7,  // 18: reviews_v1.Reviews_v1.CreateReview:output_type -> reviews_v1.CreateReviewResponse
+	9,  // 19: reviews_v1.Reviews_v1.UpdateReview:output_type -> reviews_v1.UpdateReviewResponse
+	11, // 20: reviews_v1.Reviews_v1.DeleteReview:output_type -> reviews_v1.DeleteReviewResponse
+	13, // 21: reviews_v1.Reviews_v1.MarkReviewHelpful:output_type -> reviews_v1.MarkReviewHelpfulResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_reviews_proto_init() }
@@ -504,7 +951,7 @@ func file_reviews_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviews_proto_rawDesc), len(file_reviews_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -68,6 +68,95 @@ func (m *ListReviewsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetSort()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReviewsRequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListReviewsRequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSort()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReviewsRequestValidationError{
+				field:  "Sort",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WithPhotos
+
+	if all {
+		switch v := interface{}(m.GetLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReviewsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListReviewsRequestValidationError{
+					field:  "Limit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReviewsRequestValidationError{
+				field:  "Limit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReviewsRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListReviewsRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReviewsRequestValidationError{
+				field:  "Cursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ListReviewsRequestMultiError(errors)
 	}
@@ -208,6 +297,69 @@ func (m *ListReviewsResponse) validate(all bool) error {
 
 	// no validation rules for ReviewsCount
 
+	if all {
+		switch v := interface{}(m.GetNextCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReviewsResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListReviewsResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReviewsResponseValidationError{
+				field:  "NextCursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetHistogram() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewsResponseValidationError{
+						field:  fmt.Sprintf("Histogram[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewsResponseValidationError{
+						field:  fmt.Sprintf("Histogram[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewsResponseValidationError{
+					field:  fmt.Sprintf("Histogram[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ListReviewsResponseMultiError(errors)
 	}
@@ -288,6 +440,109 @@ var _ interface {
 	ErrorName() string
 } = ListReviewsResponseValidationError{}
 
+// Validate checks the field values on GradeCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GradeCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GradeCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GradeCountMultiError, or
+// nil if none found.
+func (m *GradeCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GradeCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Grade
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GradeCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// GradeCountMultiError is an error wrapping multiple validation errors
+// returned by GradeCount.ValidateAll() if the designated constraints aren't met.
+type GradeCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GradeCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GradeCountMultiError) AllErrors() []error { return m }
+
+// GradeCountValidationError is the validation error returned by
+// GradeCount.Validate if the designated constraints aren't met.
+type GradeCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GradeCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GradeCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GradeCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GradeCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GradeCountValidationError) ErrorName() string { return "GradeCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GradeCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGradeCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GradeCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GradeCountValidationError{}
+
 // Validate checks the field values on MediaAttachment with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -542,6 +797,39 @@ func (m *Review) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetEditedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HelpfulCount
+
 	if len(errors) > 0 {
 		return ReviewMultiError(errors)
 	}
@@ -873,3 +1161,692 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CreateReviewResponseValidationError{}
+
+// Validate checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewRequestMultiError, or nil if none found.
+func (m *UpdateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateReviewRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReview() == nil {
+		err := UpdateReviewRequestValidationError{
+			field:  "Review",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewRequestValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReviewRequestValidationError is the validation error returned by
+// UpdateReviewRequest.Validate if the designated constraints aren't met.
+type UpdateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewRequestValidationError) ErrorName() string {
+	return "UpdateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewRequestValidationError{}
+
+// Validate checks the field values on UpdateReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewResponseMultiError, or nil if none found.
+func (m *UpdateReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewResponseMultiError) AllErrors() []error { return m }
+
+// UpdateReviewResponseValidationError is the validation error returned by
+// UpdateReviewResponse.Validate if the designated constraints aren't met.
+type UpdateReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewResponseValidationError) ErrorName() string {
+	return "UpdateReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewResponseValidationError{}
+
+// Validate checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReviewRequestMultiError, or nil if none found.
+func (m *DeleteReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteReviewRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReviewRequestValidationError is the validation error returned by
+// DeleteReviewRequest.Validate if the designated constraints aren't met.
+type DeleteReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewRequestValidationError) ErrorName() string {
+	return "DeleteReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewRequestValidationError{}
+
+// Validate checks the field values on DeleteReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReviewResponseMultiError, or nil if none found.
+func (m *DeleteReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteReviewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewResponseMultiError) AllErrors() []error { return m }
+
+// DeleteReviewResponseValidationError is the validation error returned by
+// DeleteReviewResponse.Validate if the designated constraints aren't met.
+type DeleteReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewResponseValidationError) ErrorName() string {
+	return "DeleteReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewResponseValidationError{}
+
+// Validate checks the field values on MarkReviewHelpfulRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkReviewHelpfulRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReviewHelpfulRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReviewHelpfulRequestMultiError, or nil if none found.
+func (m *MarkReviewHelpfulRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReviewHelpfulRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := MarkReviewHelpfulRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Helpful
+
+	if len(errors) > 0 {
+		return MarkReviewHelpfulRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReviewHelpfulRequestMultiError is an error wrapping multiple validation
+// errors returned by MarkReviewHelpfulRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MarkReviewHelpfulRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReviewHelpfulRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReviewHelpfulRequestMultiError) AllErrors() []error { return m }
+
+// MarkReviewHelpfulRequestValidationError is the validation error returned by
+// MarkReviewHelpfulRequest.Validate if the designated constraints aren't met.
+type MarkReviewHelpfulRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReviewHelpfulRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReviewHelpfulRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReviewHelpfulRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReviewHelpfulRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReviewHelpfulRequestValidationError) ErrorName() string {
+	return "MarkReviewHelpfulRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkReviewHelpfulRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReviewHelpfulRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReviewHelpfulRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReviewHelpfulRequestValidationError{}
+
+// Validate checks the field values on MarkReviewHelpfulResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkReviewHelpfulResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReviewHelpfulResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReviewHelpfulResponseMultiError, or nil if none found.
+func (m *MarkReviewHelpfulResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReviewHelpfulResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HelpfulCount
+
+	if len(errors) > 0 {
+		return MarkReviewHelpfulResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReviewHelpfulResponseMultiError is an error wrapping multiple validation
+// errors returned by MarkReviewHelpfulResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MarkReviewHelpfulResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReviewHelpfulResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReviewHelpfulResponseMultiError) AllErrors() []error { return m }
+
+// MarkReviewHelpfulResponseValidationError is the validation error returned by
+// MarkReviewHelpfulResponse.Validate if the designated constraints aren't met.
+type MarkReviewHelpfulResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReviewHelpfulResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReviewHelpfulResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReviewHelpfulResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReviewHelpfulResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReviewHelpfulResponseValidationError) ErrorName() string {
+	return "MarkReviewHelpfulResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkReviewHelpfulResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReviewHelpfulResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReviewHelpfulResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReviewHelpfulResponseValidationError{}
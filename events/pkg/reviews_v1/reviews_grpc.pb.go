@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReviewsV1_ListReviews_FullMethodName  = "/reviews_v1.Reviews_v1/ListReviews"
-	ReviewsV1_CreateReview_FullMethodName = "/reviews_v1.Reviews_v1/CreateReview"
+	ReviewsV1_ListReviews_FullMethodName       = "/reviews_v1.Reviews_v1/ListReviews"
+	ReviewsV1_CreateReview_FullMethodName      = "/reviews_v1.Reviews_v1/CreateReview"
+	ReviewsV1_UpdateReview_FullMethodName      = "/reviews_v1.Reviews_v1/UpdateReview"
+	ReviewsV1_DeleteReview_FullMethodName      = "/reviews_v1.Reviews_v1/DeleteReview"
+	ReviewsV1_MarkReviewHelpful_FullMethodName = "/reviews_v1.Reviews_v1/MarkReviewHelpful"
 )
 
 // ReviewsV1Client is the client API for ReviewsV1 service.
@@ -29,6 +32,11 @@ const (
 type ReviewsV1Client interface {
 	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
 	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error)
+	// изменить и удалить отзыв может только его автор
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewResponse, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
+	// голос "отзыв полезен", по нему работает сортировка helpful
+	MarkReviewHelpful(ctx context.Context, in *MarkReviewHelpfulRequest, opts ...grpc.CallOption) (*MarkReviewHelpfulResponse, error)
 }
 
 type reviewsV1Client struct {
@@ -59,12 +67,47 @@ func (c *reviewsV1Client) CreateReview(ctx context.Context, in *CreateReviewRequ
 	return out, nil
 }
 
+func (c *reviewsV1Client) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewsV1_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsV1Client) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewsV1_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsV1Client) MarkReviewHelpful(ctx context.Context, in *MarkReviewHelpfulRequest, opts ...grpc.CallOption) (*MarkReviewHelpfulResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkReviewHelpfulResponse)
+	err := c.cc.Invoke(ctx, ReviewsV1_MarkReviewHelpful_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewsV1Server is the server API for ReviewsV1 service.
 // All implementations must embed UnimplementedReviewsV1Server
 // for forward compatibility.
 type ReviewsV1Server interface {
 	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
 	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error)
+	// изменить и удалить отзыв может только его автор
+	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewResponse, error)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	// голос "отзыв полезен", по нему работает сортировка helpful
+	MarkReviewHelpful(context.Context, *MarkReviewHelpfulRequest) (*MarkReviewHelpfulResponse, error)
 	mustEmbedUnimplementedReviewsV1Server()
 }
 
@@ -81,6 +124,15 @@ func (UnimplementedReviewsV1Server) ListReviews(context.Context, *ListReviewsReq
 func (UnimplementedReviewsV1Server) CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
 }
+func (UnimplementedReviewsV1Server) UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewsV1Server) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedReviewsV1Server) MarkReviewHelpful(context.Context, *MarkReviewHelpfulRequest) (*MarkReviewHelpfulResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkReviewHelpful not implemented")
+}
 func (UnimplementedReviewsV1Server) mustEmbedUnimplementedReviewsV1Server() {}
 func (UnimplementedReviewsV1Server) testEmbeddedByValue()                   {}
 
@@ -138,6 +190,60 @@ func _ReviewsV1_CreateReview_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReviewsV1_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsV1Server).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsV1_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsV1Server).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsV1_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsV1Server).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsV1_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsV1Server).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsV1_MarkReviewHelpful_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReviewHelpfulRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsV1Server).MarkReviewHelpful(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewsV1_MarkReviewHelpful_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsV1Server).MarkReviewHelpful(ctx, req.(*MarkReviewHelpfulRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReviewsV1_ServiceDesc is the grpc.ServiceDesc for ReviewsV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +259,18 @@ var ReviewsV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateReview",
 			Handler:    _ReviewsV1_CreateReview_Handler,
 		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _ReviewsV1_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _ReviewsV1_DeleteReview_Handler,
+		},
+		{
+			MethodName: "MarkReviewHelpful",
+			Handler:    _ReviewsV1_MarkReviewHelpful_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reviews.proto",
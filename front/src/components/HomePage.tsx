@@ -113,7 +113,7 @@ export const HomePage = () => {
     const [events, setEvents] = useState<DisplayEvent[]>([]);
     const [isLoading, setIsLoading] = useState(true);
     const [isLoadingMore, setIsLoadingMore] = useState(false);
-    const [offset, setOffset] = useState(0);
+    const [cursor, setCursor] = useState<string | null>(null);
     const [hasMore, setHasMore] = useState(true);
     const [appliedFilters, setAppliedFilters] = useState<GetListRequest>({});
     const [uiFilters, setUiFilters] = useState<FiltersState | null>(null);
@@ -156,7 +156,7 @@ export const HomePage = () => {
                     activeTab?: string;
                     currentSort?: string;
                     events?: DisplayEvent[];
-                    offset?: number;
+                    cursor?: string | null;
                     hasMore?: boolean;
                     appliedFilters?: GetListRequest;
                     uiFilters?: FiltersState | null;
@@ -184,8 +184,8 @@ export const HomePage = () => {
                 if (hasRestorableEvents) {
                     const restoredEvents = state.events ?? [];
                     setEvents(restoredEvents);
-                    setOffset(state.offset ?? restoredEvents.length);
-                    setHasMore(state.hasMore ?? true);
+                    setCursor(state.cursor ?? null);
+                    setHasMore(Boolean(state.cursor) && (state.hasMore ?? true));
                     setLoadedImages(state.loadedImages ?? {});
                     setImageErrors(state.imageErrors ?? {});
                     setIsLoading(false);
@@ -225,7 +225,7 @@ export const HomePage = () => {
         return () => clearTimeout(timeoutId);
     }, [searchQuery, isInitialized]);
 
-    const fetchEventsPage = async (nextOffset: number, replace: boolean) => {
+    const fetchEventsPage = async (pageCursor: string | null, replace: boolean) => {
         if (replace) {
             setIsLoading(true);
         } else {
@@ -237,7 +237,7 @@ export const HomePage = () => {
                 q: debouncedSearchQuery || undefined,
                 sort: getSortParam(currentSort),
                 limit: PAGE_LIMIT,
-                offset: nextOffset,
+                cursor: pageCursor ?? undefined,
                 ...appliedFilters,
             };
             const requestKey = JSON.stringify(params);
@@ -251,8 +251,8 @@ export const HomePage = () => {
             const displayEvents = response.data.map(convertEventToDisplay);
 
             setEvents(prev => (replace ? displayEvents : [...prev, ...displayEvents]));
-            setOffset(nextOffset + displayEvents.length);
-            setHasMore(displayEvents.length === PAGE_LIMIT);
+            setCursor(response.next_cursor ?? null);
+            setHasMore(Boolean(response.next_cursor));
 
             if (response.filters) {
                 setAvailableFilters(response.filters);
@@ -283,9 +283,9 @@ export const HomePage = () => {
         }
 
         lastRequestRef.current = null;
-        setOffset(0);
+        setCursor(null);
         setHasMore(true);
-        fetchEventsPage(0, true);
+        fetchEventsPage(null, true);
     }, [debouncedSearchQuery, currentSort, appliedFilters, isInitialized]);
 
     useEffect(() => {
@@ -297,13 +297,13 @@ export const HomePage = () => {
             const scrollPosition = window.innerHeight + window.scrollY;
             const threshold = document.documentElement.scrollHeight - 300;
             if (scrollPosition >= threshold) {
-                fetchEventsPage(offset, false);
+                fetchEventsPage(cursor, false);
             }
         };
 
         window.addEventListener('scroll', handleScroll, { passive: true });
         return () => window.removeEventListener('scroll', handleScroll);
-    }, [offset, hasMore, isLoading, isLoadingMore, debouncedSearchQuery, currentSort, appliedFilters]);
+    }, [cursor, hasMore, isLoading, isLoadingMore, debouncedSearchQuery, currentSort, appliedFilters]);
 
     // Сохраняем состояние ленты и позицию скролла при размонтировании
     useEffect(() => {
@@ -320,7 +320,7 @@ export const HomePage = () => {
                     activeTab,
                     currentSort,
                     events,
-                    offset,
+                    cursor,
                     hasMore,
                     appliedFilters,
                     uiFilters,
@@ -340,7 +340,7 @@ export const HomePage = () => {
         activeTab,
         currentSort,
         events,
-        offset,
+        cursor,
         hasMore,
         appliedFilters,
         availableFilters,
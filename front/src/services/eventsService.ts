@@ -86,7 +86,8 @@ export interface GetListRequest {
     event_type?: 'offline' | 'online';
     category?: string[];
     limit?: number;
-    offset?: number;
+    // next_cursor из предыдущего ответа
+    cursor?: string;
 }
 
 export interface Category {
@@ -104,6 +105,8 @@ export interface FiltersData {
 export interface GetListResponse {
     data: Event[];
     filters?: FiltersData;
+    // курсор следующей страницы, нет - страница последняя
+    next_cursor?: string | null;
 }
 
 class EventsService {
@@ -318,7 +321,8 @@ class EventsService {
         const responseData: GetListResponse = await response.json();
         return {
             data: responseData.data || [],
-            filters: responseData.filters
+            filters: responseData.filters,
+            next_cursor: responseData.next_cursor ?? null,
         };
     }
 }
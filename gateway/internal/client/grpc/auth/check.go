@@ -22,5 +22,6 @@ func (c *authServiceClient) Check(ctx context.Context, accessToken, refreshToken
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
 		UserId:       resp.UserId,
+		Role:         resp.Role,
 	}, nil
 }
@@ -4,4 +4,5 @@ type AuthData struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 	UserId       int64  `json:"user_id"`
+	Role         string `json:"role"`
 }
@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	CtxUserIdKey = "userId"
-	tokenPrefix  = "Bearer "
+	CtxUserIdKey   = "userId"
+	CtxUserRoleKey = "userRole"
+	tokenPrefix    = "Bearer "
 )
 
 type AuthMiddleware struct {
@@ -63,6 +64,7 @@ func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 		}
 
 		ctx = context.WithValue(ctx, CtxUserIdKey, resp.UserId)
+		ctx = context.WithValue(ctx, CtxUserRoleKey, resp.Role)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
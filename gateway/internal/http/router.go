@@ -31,6 +31,13 @@ type Deps struct {
 
 func NewRouter(ctx context.Context, deps Deps) (http.Handler, error) {
 	gw := runtime.NewServeMux(
+		// x-user-* выставляет только сам gateway из проверенного токена, клиентским заголовкам не верим
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if isUserHeader(key) {
+				return "", false
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
 		runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
 			switch key {
 			case "authorization":
@@ -47,9 +54,13 @@ func NewRouter(ctx context.Context, deps Deps) (http.Handler, error) {
 				return metadata.MD{}
 			}
 
-			return metadata.Pairs(
+			md := metadata.Pairs(
 				"x-user-id", strconv.FormatInt(userID, 10),
 			)
+			if role, _ := r.Context().Value(middleware.CtxUserRoleKey).(string); role != "" {
+				md.Set("x-user-role", role)
+			}
+			return md
 		}),
 	)
 
@@ -96,10 +107,22 @@ func NewRouter(ctx context.Context, deps Deps) (http.Handler, error) {
 			gw.ServeHTTP(w, r)
 		}))
 
+		// лента календаря открывается календарными клиентами без авторизации, доступ по токену в пути
+		r.Get("/events/calendar/{token}.ics", func(w http.ResponseWriter, r *http.Request) {
+			r.URL.Path = "/events/v1/calendar/" + chi.URLParam(r, "token") + "/ics"
+			r.URL.RawPath = ""
+			gw.ServeHTTP(w, r)
+		})
+
 		r.Group(func(r chi.Router) {
 			r.Use(authMW.RequireAuth)
 			r.Handle("/events/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				r.URL.Path = "/events/v1" + strings.TrimPrefix(r.URL.Path, "/v1/events")
+				// /events/v1/{id}.ics -> /events/v1/{id}/ics, см. ExportEventIcs
+				if strings.HasSuffix(r.URL.Path, ".ics") {
+					r.URL.Path = strings.TrimSuffix(r.URL.Path, ".ics") + "/ics"
+					r.URL.RawPath = ""
+				}
 				gw.ServeHTTP(w, r)
 			}))
 			r.Handle("/user", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -107,10 +130,12 @@ func NewRouter(ctx context.Context, deps Deps) (http.Handler, error) {
 				gw.ServeHTTP(w, r)
 			}))
 
-			r.Handle("/reviews", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			reviewsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				r.URL.Path = "/reviews/v1" + strings.TrimPrefix(r.URL.Path, "/v1/reviews")
 				gw.ServeHTTP(w, r)
-			}))
+			})
+			r.Handle("/reviews", reviewsHandler)
+			r.Handle("/reviews/*", reviewsHandler)
 			r.Handle("/media", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				r.URL.Path = "/media/v1" + strings.TrimPrefix(r.URL.Path, "/v1/media")
 				gw.ServeHTTP(w, r)
@@ -121,3 +146,9 @@ func NewRouter(ctx context.Context, deps Deps) (http.Handler, error) {
 
 	return r, nil
 }
+
+func isUserHeader(key string) bool {
+	key = strings.ToLower(key)
+	key = strings.TrimPrefix(key, strings.ToLower(runtime.MetadataHeaderPrefix))
+	return strings.HasPrefix(key, "x-user-")
+}
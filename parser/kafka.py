@@ -1,8 +1,12 @@
 import asyncio
 import json
 import hashlib
+from datetime import datetime, timezone
 from aiokafka import AIOKafkaProducer
 
+# схема конверта: events/api/kafka/events_new.v1.schema.json
+SCHEMA_VERSION = 1
+
 def kafka_key(url: str) -> bytes:
     return hashlib.sha1(url.encode("utf-8")).hexdigest().encode("utf-8")
 
@@ -18,13 +22,23 @@ async def make_producer(bootstrap: str) -> AIOKafkaProducer:
     await producer.start()
     return producer
 
+def make_envelope(event: dict, source: str) -> dict:
+    return {
+        "schema_version": SCHEMA_VERSION,
+        "source": source,
+        "external_id": kafka_key(event["link"]).decode("utf-8"),
+        "produced_at": datetime.now(timezone.utc).isoformat(),
+        "event": event,
+    }
+
 async def publish_with_retry(
         producer: AIOKafkaProducer,
         topic: str,
         event: dict,
+        source: str,
         attempts: int = 5,
 ) -> None:
-    value = json.dumps(event, ensure_ascii=False).encode("utf-8")
+    value = json.dumps(make_envelope(event, source), ensure_ascii=False).encode("utf-8")
     key = kafka_key(event["link"])
 
     last_err = None
@@ -15,16 +15,30 @@ class Event:
     starts_at: Optional[str]  # ISO-8601
     venue: Optional[str]
     city: Optional[str]
-    price: Optional[int]
+    price: Optional[float]
     currency: Optional[str]
     age: Optional[int]
     address: Optional[str]
     longitude: Optional[float]
     latitude: Optional[float]
     img_url: Optional[str]
+    price_max: Optional[float] = None
+    is_free: Optional[bool] = None
 
 TBILISI = ZoneInfo("Asia/Tbilisi")
 
+def parse_price(price_raw: Optional[str]) -> tuple[Optional[float], Optional[float]]:
+    """'10 - 50 GEL' -> (10.0, 50.0), '25 GEL' -> (25.0, None)"""
+    if not price_raw:
+        return None, None
+
+    numbers = [float(n.replace(",", ".")) for n in re.findall(r"\d+(?:[.,]\d+)?", price_raw)]
+    if not numbers:
+        return None, None
+
+    price_max = max(numbers) if len(numbers) > 1 else None
+    return min(numbers), price_max
+
 def parse_datetime_loose(date_raw: str, time_raw: str) -> Optional[str]:
     if not date_raw:
         return None
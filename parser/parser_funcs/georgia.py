@@ -11,7 +11,7 @@ from redis.asyncio import Redis
 
 from geocoder import ReverseGeocoder
 from kafka import publish_with_retry
-from parser import Event, parse_datetime_loose
+from parser import Event, parse_datetime_loose, parse_price
 from cache import is_new_event, mark_seen, save_event_json
 
 
@@ -133,9 +133,7 @@ async def parse_georgia(
                             if dedup_key in seen:
                                 continue
                             seen.add(dedup_key)
-                            price_raw = price.split("-")[0].split() if price else None
-                            price = float(price_raw[0]) if price_raw else None
-                            currency = price_raw[1] if price_raw else None
+                            price_min, price_max = parse_price(price)
 
                             e = Event(
                                 link=event_url,
@@ -146,8 +144,10 @@ async def parse_georgia(
                                 starts_at=starts_at,
                                 venue=venue.strip() if venue else None,
                                 city=city,
-                                price=price if price else None,
-                                currency="GEL" if currency else None,
+                                price=price_min,
+                                price_max=price_max,
+                                is_free=price_min == 0 if price_min is not None else None,
+                                currency="GEL" if price_min is not None else None,
                                 age=None,
                                 address=address.strip() if address else None,
                                 longitude=float(longitude) if longitude else None,
@@ -158,7 +158,7 @@ async def parse_georgia(
                                 events.append(e)
 
                                 await mark_seen(redis, source, event_url)
-                                await publish_with_retry(producer, topic, asdict(e))
+                                await publish_with_retry(producer, topic, asdict(e), source)
 
                     finally:
                         await detail_page.close()